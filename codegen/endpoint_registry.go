@@ -19,8 +19,10 @@ var endpointRegistry = map[string]*additionalInfo{
 					Name:        "decoded_value",
 					Description: "The result of decoding a value.",
 					Schema: &framework.OASSchema{
-						Type:         "string",
-						DisplayAttrs: &framework.DisplayAttributes{},
+						Type: "string",
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
 					},
 				},
 				Computed: true,
@@ -34,7 +36,9 @@ var endpointRegistry = map[string]*additionalInfo{
 						Items: &framework.OASSchema{
 							Type: "object",
 						},
-						DisplayAttrs: &framework.DisplayAttributes{},
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
 					},
 				},
 				Computed: true,
@@ -49,8 +53,10 @@ var endpointRegistry = map[string]*additionalInfo{
 					Name:        "encoded_value",
 					Description: "The result of encoding a value.",
 					Schema: &framework.OASSchema{
-						Type:         "string",
-						DisplayAttrs: &framework.DisplayAttributes{},
+						Type: "string",
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
 					},
 				},
 				Computed: true,
@@ -64,7 +70,9 @@ var endpointRegistry = map[string]*additionalInfo{
 						Items: &framework.OASSchema{
 							Type: "object",
 						},
-						DisplayAttrs: &framework.DisplayAttributes{},
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
 					},
 				},
 				Computed: true,
@@ -74,8 +82,71 @@ var endpointRegistry = map[string]*additionalInfo{
 	"/transform/role/{name}": {
 		Type: tfTypeResource,
 	},
+	"/transform/stores/{name}": {
+		Type:       tfTypeResource,
+		Enterprise: true,
+		AdditionalParameters: []templatableParam{
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "connection_string",
+					Description: "A database connection string with template slots for username and password that Vault will use for locating and connecting to a database.",
+					Schema: &framework.OASSchema{
+						Type: "string",
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "password",
+					Description: "The password value to use when connecting to the database.",
+					Schema: &framework.OASSchema{
+						Type: "string",
+						DisplayAttrs: &framework.DisplayAttributes{
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	},
 	"/transform/template/{name}": {
 		Type: tfTypeResource,
+		AdditionalParameters: []templatableParam{
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "decode_formats",
+					Description: "The map of regular expression templates used to customize decoded outputs. Only applicable to FPE transformations.",
+					Schema: &framework.OASSchema{
+						Type:         "object",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "encode_format",
+					Description: "The regular expression template used for encoding values. Only applicable to FPE transformations.",
+					Schema: &framework.OASSchema{
+						Type:         "string",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:        "pattern",
+					Description: "The pattern used for matching. Currently, only regular expression pattern is supported.",
+					Schema: &framework.OASSchema{
+						Type:         "string",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+				ValidateFunc: "StringIsValidRegExp",
+			},
+		},
 	},
 	"/transform/transformation/{name}": {
 		Type: tfTypeResource,
@@ -133,6 +204,11 @@ func (t tfType) String() string {
 }
 
 type additionalInfo struct {
-	Type                 tfType
+	Type tfType
+	// Enterprise marks endpoints only served by Vault Enterprise, so that a
+	// missing mount is reported as such.
+	Enterprise bool
+	// AdditionalParameters are added to the parameters from the OpenAPI
+	// document, replacing any of the same name.
 	AdditionalParameters []templatableParam
 }
@@ -30,6 +30,7 @@ var (
 		"array",
 		"boolean",
 		"integer",
+		"object",
 		"string",
 	}
 )
@@ -107,6 +108,7 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 		SupportsRead:            endpointInfo.Get != nil,
 		SupportsWrite:           endpointInfo.Post != nil,
 		SupportsDelete:          endpointInfo.Delete != nil,
+		Enterprise:              addedInfo.Enterprise,
 	}
 	if err := t.Validate(); err != nil {
 		return nil, errwrap.Wrapf("failed to validate templatable data for "+endpoint+": {{err}}", err)
@@ -116,31 +118,40 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 
 // parseParameters walks a PathItem and looks for all the parameters
 // described. Some are at the top level of the path, indicating they are
-// path parameters. Others are only in the post body. It returns them
-// sorted and de-duplicated.
+// path parameters. Others are only in the post body. Additional parameters
+// replace those of the same name, e.g. to mark them sensitive. It returns
+// them sorted and de-duplicated.
 func parseParameters(endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) []templatableParam {
 	var result []templatableParam
-	for _, param := range addedInfo.AdditionalParameters {
-		result = append(result, param)
-	}
 	for _, param := range endpointInfo.Parameters {
 		result = append(result, toTemplatableParam(param, true))
 	}
-	if endpointInfo.Post == nil || endpointInfo.Post.RequestBody == nil || endpointInfo.Post.RequestBody.Content == nil {
-		return result
-	}
-	for _, mediaTypeObject := range endpointInfo.Post.RequestBody.Content {
-		if mediaTypeObject.Schema == nil || mediaTypeObject.Schema.Properties == nil {
-			continue
+	if endpointInfo.Post != nil && endpointInfo.Post.RequestBody != nil && endpointInfo.Post.RequestBody.Content != nil {
+		for _, mediaTypeObject := range endpointInfo.Post.RequestBody.Content {
+			if mediaTypeObject.Schema == nil || mediaTypeObject.Schema.Properties == nil {
+				continue
+			}
+			for paramName, schema := range mediaTypeObject.Schema.Properties {
+				param := framework.OASParameter{
+					Name:        paramName,
+					Description: schema.Description,
+					In:          "post",
+					Schema:      schema,
+				}
+				result = append(result, toTemplatableParam(param, false))
+			}
 		}
-		for paramName, schema := range mediaTypeObject.Schema.Properties {
-			param := framework.OASParameter{
-				Name:        paramName,
-				Description: schema.Description,
-				In:          "post",
-				Schema:      schema,
+	}
+	for _, param := range addedInfo.AdditionalParameters {
+		replaced := false
+		for i := range result {
+			if result[i].Name == param.Name {
+				result[i] = param
+				replaced = true
 			}
-			result = append(result, toTemplatableParam(param, false))
+		}
+		if !replaced {
+			result = append(result, param)
 		}
 	}
 
@@ -177,6 +188,9 @@ type templatableParam struct {
 	*framework.OASParameter
 	IsPathParam bool
 	Computed    bool
+	// ValidateFunc names a function of the SDK's validation package that
+	// validates the parameter, e.g. "StringIsValidRegExp".
+	ValidateFunc string
 }
 
 func toTemplatableParam(param framework.OASParameter, isPathParameter bool) templatableParam {
@@ -208,6 +222,18 @@ type templatableEndpoint struct {
 	SupportsRead            bool
 	SupportsWrite           bool
 	SupportsDelete          bool
+	Enterprise              bool
+}
+
+// ValidatesParameters reports whether any parameter has a ValidateFunc, in
+// which case the validation package is imported.
+func (e *templatableEndpoint) ValidatesParameters() bool {
+	for _, parameter := range e.Parameters {
+		if parameter.ValidateFunc != "" {
+			return true
+		}
+	}
+	return false
 }
 
 func (e *templatableEndpoint) Validate() error {
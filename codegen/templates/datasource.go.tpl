@@ -8,7 +8,7 @@ import (
 	"log"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
@@ -48,6 +48,10 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
                 Elem:        &schema.Schema{Type: schema.TypeMap},
                 {{- end }} {{/* end if item type object */}}
                 {{- end }} {{/* end if array */}}
+				{{- if (eq .Schema.Type "object") }}
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				{{- end }}
 				{{- if .Required }}
 				Required:    true,
 				{{- else }}
@@ -59,6 +63,9 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
                 {{- if .Computed }}
                 Computed:    true,
                 {{- end }}
+				{{- if .Schema.DisplayAttrs.Sensitive }}
+				Sensitive:   true,
+				{{- end }}
 				Description: "{{ .Description }}",
 			},
 			{{- end }}
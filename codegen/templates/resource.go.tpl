@@ -8,7 +8,10 @@ import (
 	"log"
 	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	{{- if .ValidatesParameters }}
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	{{- end }}
 	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/terraform-provider-vault/util"
 )
@@ -50,6 +53,10 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
             Elem:        &schema.Schema{Type: schema.TypeMap},
             {{- end }} {{/* end if item type object */}}
 			{{- end }} {{/* end if array */}}
+			{{- if (eq .Schema.Type "object") }}
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			{{- end }}
 			{{- if .Required }}
 			Required:    true,
 			{{- else }}
@@ -62,6 +69,9 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 			Sensitive:   true,
 			{{- end }}
 			Description: `{{ .Description }}`,
+			{{- if .ValidateFunc }}
+			ValidateFunc: validation.{{ .ValidateFunc }},
+			{{- end }}
 			{{- if .IsPathParam }}
 			ForceNew: true,
 			{{- end}}
@@ -112,6 +122,11 @@ func create{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta i
 
 	log.Printf("[DEBUG] Writing %q", vaultPath)
 	if _, err := client.Logical().Write(vaultPath, data); err != nil {
+		{{- if .Enterprise }}
+		if util.IsMountNotFoundError(err) {
+			return fmt.Errorf("error writing %q, {{ .Endpoint }} requires Vault Enterprise with its secrets engine mounted at %q: %s", vaultPath, path, err)
+		}
+		{{- end }}
 		return fmt.Errorf("error writing %q: %s", vaultPath, err)
 	}
 	d.SetId(vaultPath)
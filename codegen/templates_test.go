@@ -206,6 +206,26 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			testName: "object param",
+			input: &templatableEndpoint{
+				Endpoint:                "foo",
+				DirName:                 "foo",
+				UpperCaseDifferentiator: "foo",
+				LowerCaseDifferentiator: "foo",
+				Parameters: []templatableParam{
+					{
+						OASParameter: &framework.OASParameter{
+							Name: "foo",
+							Schema: &framework.OASSchema{
+								Type: "object",
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.testName, func(t *testing.T) {
@@ -494,6 +514,72 @@ func TestParseParameters(t *testing.T) {
 	}
 }
 
+func TestParseParametersAdditional(t *testing.T) {
+	endpointInfo := &framework.OASPathItem{
+		Parameters: []framework.OASParameter{
+			{
+				Name:     "name",
+				In:       "path",
+				Schema:   &framework.OASSchema{Type: "string"},
+				Required: true,
+			},
+		},
+		Post: &framework.OASOperation{
+			RequestBody: &framework.OASRequestBody{
+				Content: framework.OASContent{
+					"application/json": &framework.OASMediaTypeObject{
+						Schema: &framework.OASSchema{
+							Type: "object",
+							Properties: map[string]*framework.OASSchema{
+								"password": {Type: "string"},
+								"username": {Type: "string"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	addedInfo := &additionalInfo{
+		Type: tfTypeResource,
+		AdditionalParameters: []templatableParam{
+			{
+				OASParameter: &framework.OASParameter{
+					Name: "password",
+					Schema: &framework.OASSchema{
+						Type:         "string",
+						DisplayAttrs: &framework.DisplayAttributes{Sensitive: true},
+					},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name: "token",
+					Schema: &framework.OASSchema{
+						Type:         "string",
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+
+	parameters := parseParameters(endpointInfo, addedInfo)
+
+	var names []string
+	for _, parameter := range parameters {
+		names = append(names, parameter.Name)
+	}
+	expected := []string{"name", "password", "token", "username"}
+	if !reflect.DeepEqual(names, expected) {
+		t.Fatalf("expected parameters %v, got %v", expected, names)
+	}
+	if !parameters[1].Schema.DisplayAttrs.Sensitive {
+		t.Fatal("expected the additional parameter to replace password")
+	}
+}
+
 func TestTemplateHandler(t *testing.T) {
 	h, err := newTemplateHandler(hclog.Default())
 	if err != nil {
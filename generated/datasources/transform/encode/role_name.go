@@ -39,12 +39,14 @@ func RoleNameDataSource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 				Optional:    true,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The result of encoding batch_input.",
 			},
 			"encoded_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The result of encoding a value.",
 			},
 			"role_name": {
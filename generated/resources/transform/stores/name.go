@@ -0,0 +1,283 @@
+package stores
+
+// DO NOT EDIT
+// This code is generated.
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const nameEndpoint = "/transform/stores/{name}"
+
+func NameResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: `The mount path for a back-end, for example, the path given in "$ vault auth enable -path=my-aws aws".`,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"connection_string": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: `A database connection string with template slots for username and password that Vault will use for locating and connecting to a database.`,
+		},
+		"driver": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `The database driver to use, and thus the SQL database type. Currently supported are postgres, mysql and mssql.`,
+		},
+		"max_connection_lifetime": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The maximum number of seconds a connection to the SQL store may remain open.`,
+		},
+		"max_idle_connections": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The maximum number of idle connections to the SQL store.`,
+		},
+		"max_open_connections": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: `The maximum number of open connections to the SQL store.`,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: `The name of the store.`,
+			ForceNew:    true,
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: `The password value to use when connecting to the database.`,
+		},
+		"supported_transformations": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: `The types of transformations this store can host. Currently only tokenization is supported.`,
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `The type of store. Currently only sql is supported.`,
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `The username value to use when connecting to the database.`,
+		},
+	}
+	return &schema.Resource{
+		Create: createNameResource,
+		Update: updateNameResource,
+		Read:   readNameResource,
+		Exists: resourceNameExists,
+		Delete: deleteNameResource,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+func createNameResource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Get("path").(string)
+	vaultPath := util.ParsePath(path, nameEndpoint, d)
+	log.Printf("[DEBUG] Creating %q", vaultPath)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOkExists("connection_string"); ok {
+		data["connection_string"] = v
+	}
+	if v, ok := d.GetOkExists("driver"); ok {
+		data["driver"] = v
+	}
+	if v, ok := d.GetOkExists("max_connection_lifetime"); ok {
+		data["max_connection_lifetime"] = v
+	}
+	if v, ok := d.GetOkExists("max_idle_connections"); ok {
+		data["max_idle_connections"] = v
+	}
+	if v, ok := d.GetOkExists("max_open_connections"); ok {
+		data["max_open_connections"] = v
+	}
+	data["name"] = d.Get("name")
+	if v, ok := d.GetOkExists("password"); ok {
+		data["password"] = v
+	}
+	if v, ok := d.GetOkExists("supported_transformations"); ok {
+		data["supported_transformations"] = v
+	}
+	if v, ok := d.GetOkExists("type"); ok {
+		data["type"] = v
+	}
+	if v, ok := d.GetOkExists("username"); ok {
+		data["username"] = v
+	}
+
+	log.Printf("[DEBUG] Writing %q", vaultPath)
+	if _, err := client.Logical().Write(vaultPath, data); err != nil {
+		if util.IsMountNotFoundError(err) {
+			return fmt.Errorf("error writing %q, /transform/stores/{name} requires Vault Enterprise with its secrets engine mounted at %q: %s", vaultPath, path, err)
+		}
+		return fmt.Errorf("error writing %q: %s", vaultPath, err)
+	}
+	d.SetId(vaultPath)
+	log.Printf("[DEBUG] Wrote %q", vaultPath)
+	return readNameResource(d, meta)
+}
+
+func readNameResource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	vaultPath := d.Id()
+	log.Printf("[DEBUG] Reading %q", vaultPath)
+
+	resp, err := client.Logical().Read(vaultPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", vaultPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", vaultPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", vaultPath)
+		d.SetId("")
+		return nil
+	}
+	pathParams, err := util.PathParameters(nameEndpoint, vaultPath)
+	if err != nil {
+		return err
+	}
+	for paramName, paramVal := range pathParams {
+		if err := d.Set(paramName, paramVal); err != nil {
+			return fmt.Errorf("error setting state %q, %q: %s", paramName, paramVal, err)
+		}
+	}
+	if val, ok := resp.Data["connection_string"]; ok {
+		if err := d.Set("connection_string", val); err != nil {
+			return fmt.Errorf("error setting state key 'connection_string': %s", err)
+		}
+	}
+	if val, ok := resp.Data["driver"]; ok {
+		if err := d.Set("driver", val); err != nil {
+			return fmt.Errorf("error setting state key 'driver': %s", err)
+		}
+	}
+	if val, ok := resp.Data["max_connection_lifetime"]; ok {
+		if err := d.Set("max_connection_lifetime", val); err != nil {
+			return fmt.Errorf("error setting state key 'max_connection_lifetime': %s", err)
+		}
+	}
+	if val, ok := resp.Data["max_idle_connections"]; ok {
+		if err := d.Set("max_idle_connections", val); err != nil {
+			return fmt.Errorf("error setting state key 'max_idle_connections': %s", err)
+		}
+	}
+	if val, ok := resp.Data["max_open_connections"]; ok {
+		if err := d.Set("max_open_connections", val); err != nil {
+			return fmt.Errorf("error setting state key 'max_open_connections': %s", err)
+		}
+	}
+	if val, ok := resp.Data["password"]; ok {
+		if err := d.Set("password", val); err != nil {
+			return fmt.Errorf("error setting state key 'password': %s", err)
+		}
+	}
+	if val, ok := resp.Data["supported_transformations"]; ok {
+		if err := d.Set("supported_transformations", val); err != nil {
+			return fmt.Errorf("error setting state key 'supported_transformations': %s", err)
+		}
+	}
+	if val, ok := resp.Data["type"]; ok {
+		if err := d.Set("type", val); err != nil {
+			return fmt.Errorf("error setting state key 'type': %s", err)
+		}
+	}
+	if val, ok := resp.Data["username"]; ok {
+		if err := d.Set("username", val); err != nil {
+			return fmt.Errorf("error setting state key 'username': %s", err)
+		}
+	}
+	return nil
+}
+
+func updateNameResource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	vaultPath := d.Id()
+	log.Printf("[DEBUG] Updating %q", vaultPath)
+
+	data := map[string]interface{}{}
+	if raw, ok := d.GetOk("connection_string"); ok {
+		data["connection_string"] = raw
+	}
+	if raw, ok := d.GetOk("driver"); ok {
+		data["driver"] = raw
+	}
+	if raw, ok := d.GetOk("max_connection_lifetime"); ok {
+		data["max_connection_lifetime"] = raw
+	}
+	if raw, ok := d.GetOk("max_idle_connections"); ok {
+		data["max_idle_connections"] = raw
+	}
+	if raw, ok := d.GetOk("max_open_connections"); ok {
+		data["max_open_connections"] = raw
+	}
+	if raw, ok := d.GetOk("password"); ok {
+		data["password"] = raw
+	}
+	if raw, ok := d.GetOk("supported_transformations"); ok {
+		data["supported_transformations"] = raw
+	}
+	if raw, ok := d.GetOk("type"); ok {
+		data["type"] = raw
+	}
+	if raw, ok := d.GetOk("username"); ok {
+		data["username"] = raw
+	}
+	if _, err := client.Logical().Write(vaultPath, data); err != nil {
+		return fmt.Errorf("error updating template auth backend role %q: %s", vaultPath, err)
+	}
+	log.Printf("[DEBUG] Updated %q", vaultPath)
+	return readNameResource(d, meta)
+}
+
+func deleteNameResource(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	vaultPath := d.Id()
+	log.Printf("[DEBUG] Deleting %q", vaultPath)
+
+	if _, err := client.Logical().Delete(vaultPath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", vaultPath, err)
+	} else if err != nil {
+		log.Printf("[DEBUG] %q not found, removing from state", vaultPath)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Deleted template auth backend role %q", vaultPath)
+	return nil
+}
+
+func resourceNameExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+	vaultPath := d.Id()
+	log.Printf("[DEBUG] Checking if %q exists", vaultPath)
+
+	resp, err := client.Logical().Read(vaultPath)
+	if err != nil {
+		return true, fmt.Errorf("error checking if %q exists: %s", vaultPath, err)
+	}
+	log.Printf("[DEBUG] Checked if %q exists", vaultPath)
+	return resp != nil, nil
+}
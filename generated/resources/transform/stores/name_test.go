@@ -0,0 +1,102 @@
+package stores
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	sdk_schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+
+	"github.com/hashicorp/terraform-provider-vault/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/terraform-provider-vault/vault"
+)
+
+var nameTestProvider = func() *schema.Provider {
+	p := schema.NewProvider(vault.Provider())
+	p.RegisterResource("vault_mount", vault.MountResource())
+	p.RegisterResource("vault_transform_store_name", NameResource())
+	return p
+}()
+
+func TestStoreName(t *testing.T) {
+	connURL := os.Getenv("POSTGRES_URL")
+	if connURL == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+	path := acctest.RandomWithPrefix("transform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { util.TestEntPreCheck(t) },
+		Providers: map[string]*sdk_schema.Provider{
+			"vault": nameTestProvider.SchemaProvider(),
+		},
+		CheckDestroy: destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: basicConfig(path, connURL, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "path", path),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "name", "postgres"),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "type", "sql"),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "driver", "postgres"),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "supported_transformations.#", "1"),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "supported_transformations.0", "tokenization"),
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "max_open_connections", "4"),
+				),
+			},
+			{
+				Config: basicConfig(path, connURL, 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_store_name.test", "max_open_connections", "8"),
+				),
+			},
+			{
+				ResourceName:            "vault_transform_store_name.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"connection_string", "password"},
+			},
+		},
+	})
+}
+
+func destroy(s *terraform.State) error {
+	client := nameTestProvider.SchemaProvider().Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_transform_store_name" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for store %q: %s", rs.Primary.ID, err)
+		}
+		if secret != nil {
+			return fmt.Errorf("store %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func basicConfig(path, connURL string, maxOpenConnections int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "mount_transform" {
+  path = "%s"
+  type = "transform"
+}
+resource "vault_transform_store_name" "test" {
+  path                      = vault_mount.mount_transform.path
+  name                      = "postgres"
+  type                      = "sql"
+  driver                    = "postgres"
+  supported_transformations = ["tokenization"]
+  connection_string         = "%s"
+  max_open_connections      = %d
+}
+`, path, connURL, maxOpenConnections)
+}
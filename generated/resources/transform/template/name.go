@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -31,6 +32,17 @@ func NameResource() *schema.Resource {
 			Optional:    true,
 			Description: `The alphabet to use for this template. This is only used during FPE transformations.`,
 		},
+		"decode_formats": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: `The map of regular expression templates used to customize decoded outputs. Only applicable to FPE transformations.`,
+		},
+		"encode_format": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: `The regular expression template used for encoding values. Only applicable to FPE transformations.`,
+		},
 		"name": {
 			Type:        schema.TypeString,
 			Required:    true,
@@ -38,9 +50,10 @@ func NameResource() *schema.Resource {
 			ForceNew:    true,
 		},
 		"pattern": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: `The pattern used for matching. Currently, only regular expression pattern is supported.`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  `The pattern used for matching. Currently, only regular expression pattern is supported.`,
+			ValidateFunc: validation.StringIsValidRegExp,
 		},
 		"type": {
 			Type:        schema.TypeString,
@@ -70,6 +83,12 @@ func createNameResource(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOkExists("alphabet"); ok {
 		data["alphabet"] = v
 	}
+	if v, ok := d.GetOkExists("decode_formats"); ok {
+		data["decode_formats"] = v
+	}
+	if v, ok := d.GetOkExists("encode_format"); ok {
+		data["encode_format"] = v
+	}
 	data["name"] = d.Get("name")
 	if v, ok := d.GetOkExists("pattern"); ok {
 		data["pattern"] = v
@@ -116,6 +135,16 @@ func readNameResource(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error setting state key 'alphabet': %s", err)
 		}
 	}
+	if val, ok := resp.Data["decode_formats"]; ok {
+		if err := d.Set("decode_formats", val); err != nil {
+			return fmt.Errorf("error setting state key 'decode_formats': %s", err)
+		}
+	}
+	if val, ok := resp.Data["encode_format"]; ok {
+		if err := d.Set("encode_format", val); err != nil {
+			return fmt.Errorf("error setting state key 'encode_format': %s", err)
+		}
+	}
 	if val, ok := resp.Data["pattern"]; ok {
 		if err := d.Set("pattern", val); err != nil {
 			return fmt.Errorf("error setting state key 'pattern': %s", err)
@@ -138,6 +167,12 @@ func updateNameResource(d *schema.ResourceData, meta interface{}) error {
 	if raw, ok := d.GetOk("alphabet"); ok {
 		data["alphabet"] = raw
 	}
+	if raw, ok := d.GetOk("decode_formats"); ok {
+		data["decode_formats"] = raw
+	}
+	if raw, ok := d.GetOk("encode_format"); ok {
+		data["encode_format"] = raw
+	}
 	if raw, ok := d.GetOk("pattern"); ok {
 		data["pattern"] = raw
 	}
@@ -2,6 +2,7 @@ package template
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -54,6 +55,37 @@ func TestTemplateName(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_transform_template_name.test", "alphabet", "builtin/numeric"),
 				),
 			},
+			{
+				Config:      basicConfig(path, "regex", `(\\d{9}`, "builtin/numeric"),
+				ExpectError: regexp.MustCompile(`"pattern": error parsing regexp`),
+			},
+			{
+				ResourceName:      "vault_transform_template_name.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTemplateNameFormats(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { util.TestEntPreCheck(t) },
+		Providers: map[string]*sdk_schema.Provider{
+			"vault": nameTestProvider.SchemaProvider(),
+		},
+		CheckDestroy: destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: formatsConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transform_template_name.test", "encode_format", "$1-$2-$3-$4"),
+					resource.TestCheckResourceAttr("vault_transform_template_name.test", "decode_formats.%", "1"),
+					resource.TestCheckResourceAttr("vault_transform_template_name.test", "decode_formats.last-four", "$4"),
+				),
+			},
 			{
 				ResourceName:      "vault_transform_template_name.test",
 				ImportState:       true,
@@ -101,3 +133,23 @@ resource "vault_transform_template_name" "test" {
 }
 `, path, tp, pattern, alphabet)
 }
+
+func formatsConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+resource "vault_transform_template_name" "test" {
+  path          = vault_mount.transform.path
+  name          = "ccn"
+  type          = "regex"
+  pattern       = "(\\d{4})-(\\d{4})-(\\d{4})-(\\d{4})"
+  alphabet      = "builtin/numeric"
+  encode_format = "$1-$2-$3-$4"
+  decode_formats = {
+    "last-four" = "$4"
+  }
+}
+`, path)
+}
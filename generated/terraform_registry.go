@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-provider-vault/generated/datasources/transform/encode"
 	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/alphabet"
 	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/role"
+	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/stores"
 	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/template"
 	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/transformation"
 )
@@ -20,6 +21,7 @@ var DataSourceRegistry = map[string]*schema.Resource{
 var ResourceRegistry = map[string]*schema.Resource{
 	"vault_transform_alphabet":       alphabet.NameResource(),
 	"vault_transform_role":           role.NameResource(),
+	"vault_transform_store":          stores.NameResource(),
 	"vault_transform_template":       template.NameResource(),
 	"vault_transform_transformation": transformation.NameResource(),
 }
@@ -11,10 +11,10 @@ import (
 func main() {
 	p := schema.NewProvider(vault.Provider())
 	for name, resource := range generated.DataSourceRegistry {
-		p.RegisterDataSource(name, resource)
+		p.RegisterDataSource(name, vault.WithNamespace(resource, true))
 	}
 	for name, resource := range generated.ResourceRegistry {
-		p.RegisterResource(name, resource)
+		p.RegisterResource(name, vault.WithNamespace(resource, false))
 	}
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: p.SchemaProvider})
@@ -2,6 +2,7 @@ package util
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
 )
 
 func JsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
@@ -31,6 +33,23 @@ func JsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldJSON, newJSON)
 }
 
+// DurationDiffSuppress suppresses the diff between two duration strings that
+// represent the same amount of time, e.g. "1h" and "1h0m0s".
+func DurationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+	oldDur, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	newDur, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return oldDur == newDur
+}
+
 func ToStringArray(input []interface{}) []string {
 	output := make([]string, len(input))
 
@@ -45,6 +64,43 @@ func Is404(err error) bool {
 	return strings.Contains(err.Error(), "Code: 404")
 }
 
+// ErrorContainsHTTPCode returns true if the given error is a Vault API error
+// with any of the given HTTP status codes.
+func ErrorContainsHTTPCode(err error, codes ...int) bool {
+	if err == nil {
+		return false
+	}
+
+	var respErr *api.ResponseError
+	if errors.As(err, &respErr) {
+		for _, code := range codes {
+			if respErr.StatusCode == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the error may have been flattened into a string somewhere along the way
+	for _, code := range codes {
+		if strings.Contains(err.Error(), fmt.Sprintf("Code: %d", code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMountNotFoundError returns true if the error is a 404 raised because no
+// mount is handling the requested path, as opposed to a missing object in an
+// existing mount.
+func IsMountNotFoundError(err error) bool {
+	if err == nil || !Is404(err) {
+		return false
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "no handler for route") || strings.Contains(msg, "unsupported path")
+}
+
 func CalculateConflictsWith(self string, group []string) []string {
 	if len(group) < 2 {
 		return []string{}
@@ -150,6 +206,20 @@ func GetTestNomadCreds(t *testing.T) (string, string) {
 	return address, token
 }
 
+func GetTestConsulCreds(t *testing.T) (string, string) {
+	address := os.Getenv("CONSUL_HTTP_ADDR")
+	token := os.Getenv("CONSUL_HTTP_TOKEN")
+
+	if address == "" {
+		t.Skip("CONSUL_HTTP_ADDR not set")
+	}
+	if token == "" {
+		t.Skip("CONSUL_HTTP_TOKEN not set")
+	}
+
+	return address, token
+}
+
 func TestCheckResourceAttrJSON(name, key, expectedValue string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState, ok := s.RootModule().Resources[name]
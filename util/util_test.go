@@ -2,9 +2,11 @@ package util
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 )
 
 type testingStruct struct {
@@ -27,6 +29,71 @@ func TestExpiredTokenError(t *testing.T) {
 	}
 }
 
+func TestDurationDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		{"1h", "1h", true},
+		{"1h0m0s", "1h", true},
+		{"60m", "1h0m0s", true},
+		{"0s", "0", true},
+		{"1h", "2h", false},
+		{"", "1h", false},
+		{"1h", "foo", false},
+	}
+	for _, tt := range tests {
+		if actual := DurationDiffSuppress("", tt.old, tt.new, nil); actual != tt.expected {
+			t.Errorf("DurationDiffSuppress(%q, %q) expected %t, actual %t", tt.old, tt.new, tt.expected, actual)
+		}
+	}
+}
+
+func TestErrorContainsHTTPCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		codes    []int
+		expected bool
+	}{
+		{"nil", nil, []int{409}, false},
+		{"response error match", &api.ResponseError{StatusCode: 409}, []int{409, 429}, true},
+		{"response error no match", &api.ResponseError{StatusCode: 500}, []int{409, 429}, false},
+		{"wrapped response error", fmt.Errorf("write failed: %w", &api.ResponseError{StatusCode: 429}), []int{409, 429}, true},
+		{"string error match", fmt.Errorf("Error making API request.\n\nCode: 409. Errors:"), []int{409}, true},
+		{"string error no match", fmt.Errorf("Error making API request.\n\nCode: 400. Errors:"), []int{409}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ErrorContainsHTTPCode(tt.err, tt.codes...); actual != tt.expected {
+				t.Errorf("expected %t, actual %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestIsMountNotFoundError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"no handler", fmt.Errorf("Code: 404. Errors:\n\n* no handler for route 'auth/approle/role/foo'"), true},
+		{"unsupported path", fmt.Errorf("Code: 404. Errors:\n\n* 1 error occurred:\n\t* unsupported path"), true},
+		{"object not found", fmt.Errorf("Code: 404. Errors:\n\n* role not found"), false},
+		{"other code", fmt.Errorf("Code: 400. Errors:\n\n* no handler for route 'auth/approle/role/foo'"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := IsMountNotFoundError(tt.err); actual != tt.expected {
+				t.Errorf("expected %t, actual %t", tt.expected, actual)
+			}
+		})
+	}
+}
+
 func TestSliceHasElement_scalar(t *testing.T) {
 	slice := []interface{}{1, 2, 3, 4, 5}
 
@@ -4,9 +4,13 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -47,7 +51,7 @@ func authMountTuneSchema() *schema.Schema {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Description:  "Specifies whether to show this mount in the UI-specific listing endpoint. Valid values are \"unauth\" or \"hidden\". If not set, behaves like \"hidden\".",
-					ValidateFunc: validation.StringInSlice([]string{"unauth", "hidden"}, false),
+					ValidateFunc: validation.StringInSlice(mountListingVisibilityValues, false),
 				},
 				"passthrough_request_headers": {
 					Type:        schema.TypeList,
@@ -65,7 +69,7 @@ func authMountTuneSchema() *schema.Schema {
 					Type:         schema.TypeString,
 					Optional:     true,
 					Description:  "Specifies the type of tokens that should be returned by the mount.",
-					ValidateFunc: validation.StringInSlice([]string{"default-service", "default-batch", "service", "batch"}, false),
+					ValidateFunc: validation.StringInSlice(tokenTypes, false),
 				},
 			},
 		},
@@ -85,6 +89,106 @@ func authMountInfoGet(client *api.Client, path string) (*api.AuthMount, error) {
 	return authMount, nil
 }
 
+// authMountAccessorCache holds the accessors of the auth mounts, keyed by
+// mount path, and their types, keyed by accessor, so that resolving many
+// paths or accessors only lists the auth mounts once.
+var authMountAccessorCache = struct {
+	sync.RWMutex
+	accessors map[string]string
+	types     map[string]string
+}{accessors: map[string]string{}, types: map[string]string{}}
+
+// authMountAccessor returns the accessor of the auth mount at path.
+func authMountAccessor(client *api.Client, path string) (string, error) {
+	prefix := mountCacheKeyPrefix(client)
+	key := prefix + strings.Trim(path, "/") + "/"
+
+	authMountAccessorCache.RLock()
+	accessor, ok := authMountAccessorCache.accessors[key]
+	authMountAccessorCache.RUnlock()
+	if ok {
+		return accessor, nil
+	}
+
+	log.Printf("[DEBUG] Listing auth mounts to resolve the accessor of %q", path)
+	if err := authMountAccessorCacheFill(client); err != nil {
+		return "", err
+	}
+
+	authMountAccessorCache.RLock()
+	accessor, ok = authMountAccessorCache.accessors[key]
+	authMountAccessorCache.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("auth mount %s not present", path)
+	}
+
+	return accessor, nil
+}
+
+// authMountType returns the auth method type of the auth mount with accessor.
+func authMountType(client *api.Client, accessor string) (string, error) {
+	key := mountCacheKeyPrefix(client) + accessor
+
+	authMountAccessorCache.RLock()
+	mountType, ok := authMountAccessorCache.types[key]
+	authMountAccessorCache.RUnlock()
+	if ok {
+		return mountType, nil
+	}
+
+	log.Printf("[DEBUG] Listing auth mounts to resolve the type of accessor %q", accessor)
+	if err := authMountAccessorCacheFill(client); err != nil {
+		return "", err
+	}
+
+	authMountAccessorCache.RLock()
+	mountType, ok = authMountAccessorCache.types[key]
+	authMountAccessorCache.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("no auth mount found with accessor %q", accessor)
+	}
+
+	return mountType, nil
+}
+
+func authMountAccessorCacheFill(client *api.Client) error {
+	prefix := mountCacheKeyPrefix(client)
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from auth mounts: %s", err)
+	}
+
+	authMountAccessorCache.Lock()
+	for p, auth := range auths {
+		authMountAccessorCache.accessors[prefix+p] = auth.Accessor
+		authMountAccessorCache.types[prefix+auth.Accessor] = auth.Type
+	}
+	authMountAccessorCache.Unlock()
+
+	return nil
+}
+
+// authMountAccessorCacheInvalidate drops the cached accessors and types of
+// client's auth mounts, which must be called whenever the provider enables or
+// disables an auth mount.
+func authMountAccessorCacheInvalidate(client *api.Client) {
+	prefix := mountCacheKeyPrefix(client)
+
+	authMountAccessorCache.Lock()
+	for k := range authMountAccessorCache.accessors {
+		if strings.HasPrefix(k, prefix) {
+			delete(authMountAccessorCache.accessors, k)
+		}
+	}
+	for k := range authMountAccessorCache.types {
+		if strings.HasPrefix(k, prefix) {
+			delete(authMountAccessorCache.types, k)
+		}
+	}
+	authMountAccessorCache.Unlock()
+}
+
 func authMountTune(client *api.Client, path string, configured interface{}) error {
 	tune := expandAuthMethodTune(configured.(*schema.Set).List())
 
@@ -105,8 +209,82 @@ func authMountTuneGet(client *api.Client, path string) (map[string]interface{},
 	return flattenAuthMethodTune(tune), nil
 }
 
+// mergeAuthMountTune reconciles the tune values read from Vault with the
+// tune block currently held in state. Durations that are equivalent to the
+// configured value keep their configured representation, e.g. "3600s" rather
+// than "1h", and keys that are not set in the prior block are left unset since
+// Vault always reports a value for them. If there is no prior block, as on
+// import, the values from Vault are used as is.
+func mergeAuthMountTune(prior []interface{}, remote map[string]interface{}) map[string]interface{} {
+	if len(prior) == 0 || prior[0] == nil {
+		return remote
+	}
+	configured := prior[0].(map[string]interface{})
+
+	result := make(map[string]interface{})
+	for k, v := range configured {
+		switch cv := v.(type) {
+		case string:
+			if cv == "" {
+				result[k] = cv
+				continue
+			}
+			rv, _ := remote[k].(string)
+			if k == "default_lease_ttl" || k == "max_lease_ttl" {
+				if util.DurationDiffSuppress(k, cv, rv, nil) {
+					rv = cv
+				}
+			}
+			// Vault stores "default" as the token type it stands for.
+			if k == "token_type" && cv == "default" && rv == "default-service" {
+				rv = cv
+			}
+			result[k] = rv
+		case []interface{}:
+			if len(cv) == 0 {
+				result[k] = cv
+				continue
+			}
+			if rv, ok := remote[k]; ok {
+				result[k] = rv
+			} else {
+				result[k] = []interface{}{}
+			}
+		default:
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// authMountRetryTimeout caps how long a write is retried while a newly
+// enabled auth mount is not yet visible.
+var authMountRetryTimeout = 10 * time.Second
+
+// authMountRetryWrite writes to a path within an auth mount, retrying while
+// Vault reports that no mount handles the path. This covers the short window
+// after a mount is enabled in which it may not be routable yet, e.g. on a
+// performance standby.
+func authMountRetryWrite(client *api.Client, path string, data map[string]interface{}) (*api.Secret, error) {
+	var resp *api.Secret
+	err := resource.Retry(authMountRetryTimeout, func() *resource.RetryError {
+		var err error
+		resp, err = client.Logical().Write(path, data)
+		if err != nil {
+			if util.IsMountNotFoundError(err) {
+				log.Printf("[DEBUG] Mount for %q not found, retrying: %s", path, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return resp, err
+}
+
 func authMountDisable(client *api.Client, path string) error {
 	log.Printf("[DEBUG] Disabling auth mount config from '%q'", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().DisableAuth(path)
 	if err != nil {
 		return fmt.Errorf("error disabling auth mount from '%q': %s", path, err)
@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMergeAuthMountTune(t *testing.T) {
+	remote := map[string]interface{}{
+		"default_lease_ttl":           "1m",
+		"max_lease_ttl":               "2h",
+		"audit_non_hmac_request_keys": []interface{}{"foo"},
+		"listing_visibility":          "unauth",
+		"token_type":                  "default-service",
+	}
+
+	tests := []struct {
+		name     string
+		prior    []interface{}
+		expected map[string]interface{}
+	}{
+		{
+			name:     "no prior tune",
+			prior:    nil,
+			expected: remote,
+		},
+		{
+			name: "equivalent durations keep configured form",
+			prior: []interface{}{
+				map[string]interface{}{
+					"default_lease_ttl":           "60s",
+					"max_lease_ttl":               "7200s",
+					"audit_non_hmac_request_keys": []interface{}{"foo"},
+					"listing_visibility":          "unauth",
+					"token_type":                  "default-service",
+				},
+			},
+			expected: map[string]interface{}{
+				"default_lease_ttl":           "60s",
+				"max_lease_ttl":               "7200s",
+				"audit_non_hmac_request_keys": []interface{}{"foo"},
+				"listing_visibility":          "unauth",
+				"token_type":                  "default-service",
+			},
+		},
+		{
+			name: "drift is reported",
+			prior: []interface{}{
+				map[string]interface{}{
+					"default_lease_ttl":           "30s",
+					"max_lease_ttl":               "2h",
+					"audit_non_hmac_request_keys": []interface{}{"bar"},
+					"listing_visibility":          "hidden",
+					"token_type":                  "batch",
+				},
+			},
+			expected: remote,
+		},
+		{
+			name: "default token type keeps configured form",
+			prior: []interface{}{
+				map[string]interface{}{
+					"max_lease_ttl": "2h",
+					"token_type":    "default",
+				},
+			},
+			expected: map[string]interface{}{
+				"max_lease_ttl": "2h",
+				"token_type":    "default",
+			},
+		},
+		{
+			name: "unset keys stay unset",
+			prior: []interface{}{
+				map[string]interface{}{
+					"default_lease_ttl":           "",
+					"max_lease_ttl":               "2h",
+					"audit_non_hmac_request_keys": []interface{}{},
+					"listing_visibility":          "",
+					"token_type":                  "",
+				},
+			},
+			expected: map[string]interface{}{
+				"default_lease_ttl":           "",
+				"max_lease_ttl":               "2h",
+				"audit_non_hmac_request_keys": []interface{}{},
+				"listing_visibility":          "",
+				"token_type":                  "",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := mergeAuthMountTune(tt.prior, remote)
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAuthMountRetryWrite(t *testing.T) {
+	tests := []struct {
+		name             string
+		firstStatus      int
+		firstBody        string
+		expectErr        bool
+		expectedRequests int
+	}{
+		{
+			name:             "retry while mount is not routable",
+			firstStatus:      http.StatusNotFound,
+			firstBody:        `{"errors":["no handler for route 'auth/approle/role/test'"]}`,
+			expectErr:        false,
+			expectedRequests: 2,
+		},
+		{
+			name:             "no retry on other errors",
+			firstStatus:      http.StatusBadRequest,
+			firstBody:        `{"errors":["invalid role name"]}`,
+			expectErr:        true,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.Header().Set("Content-Type", "application/json")
+				if requests == 1 {
+					w.WriteHeader(tt.firstStatus)
+					fmt.Fprint(w, tt.firstBody)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			_, err := authMountRetryWrite(client, "auth/approle/role/test", map[string]interface{}{})
+			if tt.expectErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.expectErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if requests != tt.expectedRequests {
+				t.Fatalf("expected %d requests, got %d", tt.expectedRequests, requests)
+			}
+		})
+	}
+}
+
+func TestAuthMountAccessor(t *testing.T) {
+	requests := 0
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{`+
+			`"github/":{"type":"github","accessor":"auth_github_1234"},`+
+			`"token/":{"type":"token","accessor":"auth_token_5678"}}}`)
+	})
+	defer authMountAccessorCacheInvalidate(client)
+
+	for _, path := range []string{"github", "/github/", "token"} {
+		accessor, err := authMountAccessor(client, path)
+		if err != nil {
+			t.Fatalf("unexpected error resolving %q: %s", path, err)
+		}
+		expected := "auth_github_1234"
+		if path == "token" {
+			expected = "auth_token_5678"
+		}
+		if accessor != expected {
+			t.Fatalf("expected accessor %q for %q, got %q", expected, path, accessor)
+		}
+	}
+	if requests != 1 {
+		t.Fatalf("expected the auth mounts to be listed once, got %d requests", requests)
+	}
+
+	if _, err := authMountAccessor(client, "userpass"); err == nil {
+		t.Fatal("expected an error for an unknown auth mount")
+	}
+	if requests != 2 {
+		t.Fatalf("expected a cache miss to list the auth mounts again, got %d requests", requests)
+	}
+
+	authMountAccessorCacheInvalidate(client)
+	if _, err := authMountAccessor(client, "github"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if requests != 3 {
+		t.Fatalf("expected the auth mounts to be listed after invalidation, got %d requests", requests)
+	}
+}
@@ -1,12 +1,20 @@
 package vault
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+// tokenTypes are the values Vault accepts for token_type.
+var tokenTypes = []string{"service", "batch", "default", "default-service", "default-batch"}
+
 func commonTokenFields() []string {
 	return []string{
 		"token_bound_cidrs",
@@ -43,9 +51,14 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 		Type: schema.TypeSet,
 		Elem: &schema.Schema{
 			Type: schema.TypeString,
+			StateFunc: func(v interface{}) string {
+				return normalizeTokenBoundCIDR(v.(string))
+			},
 		},
-		Description: "Specifies the blocks of IP addresses which are allowed to use the generated token",
-		Optional:    true,
+		Set:              hashTokenBoundCIDR,
+		DiffSuppressFunc: suppressEquivalentCIDRDiff,
+		Description:      "Specifies the blocks of IP addresses which are allowed to use the generated token",
+		Optional:         true,
 	}
 
 	fields["token_explicit_max_ttl"] = &schema.Schema{
@@ -86,10 +99,11 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	}
 
 	fields["token_type"] = &schema.Schema{
-		Type:        schema.TypeString,
-		Description: "The type of token to generate, service or batch",
-		Optional:    true,
-		Default:     config.TokenTypeDefault,
+		Type:         schema.TypeString,
+		Description:  "The type of token to generate, service or batch",
+		Optional:     true,
+		Default:      config.TokenTypeDefault,
+		ValidateFunc: validation.StringInSlice(tokenTypes, false),
 	}
 
 	fields["token_ttl"] = &schema.Schema{
@@ -107,6 +121,47 @@ func addTokenFields(fields map[string]*schema.Schema, config *addTokenFieldsConf
 	}
 }
 
+// tokenPeriodWarning wraps the Create or Update function f to warn when any of
+// periodFields is set together with any of maxTTLFields. Vault accepts the
+// combination, but periodic tokens are meant to be renewed indefinitely, so a
+// maximum TTL on the same role rarely gives the lifetime intended.
+func tokenPeriodWarning(f func(*schema.ResourceData, interface{}) error, periodFields, maxTTLFields []string) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := f(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		period := tokenFieldsFirstSet(d, periodFields)
+		if period == "" {
+			return nil
+		}
+		maxTTL := tokenFieldsFirstSet(d, maxTTLFields)
+		if maxTTL == "" {
+			return nil
+		}
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("%s is combined with %s", period, maxTTL),
+				Detail: fmt.Sprintf("Vault accepts the combination, but the maximum TTL may end periodic tokens "+
+					"that are expected to be renewed indefinitely. Unset %s unless the tokens are meant to expire.",
+					maxTTL),
+			},
+		}
+	}
+}
+
+// tokenFieldsFirstSet returns the first of fields with a non-zero value, or an
+// empty string if there is none.
+func tokenFieldsFirstSet(d *schema.ResourceData, fields []string) string {
+	for _, k := range fields {
+		if _, ok := d.GetOk(k); ok {
+			return k
+		}
+	}
+	return ""
+}
+
 func setTokenFields(d *schema.ResourceData, data map[string]interface{}, config *addTokenFieldsConfig) {
 	data["token_no_default_policy"] = d.Get("token_no_default_policy").(bool)
 	data["token_type"] = d.Get("token_type").(string)
@@ -220,11 +275,11 @@ func updateTokenFields(d *schema.ResourceData, data map[string]interface{}, crea
 			data["token_type"] = v.(string)
 		}
 
-		if v, ok := d.GetOk("token_ttl"); ok {
+		if v, ok := d.GetOkExists("token_ttl"); ok {
 			data["token_ttl"] = v.(int)
 		}
 
-		if v, ok := d.GetOk("token_num_uses"); ok {
+		if v, ok := d.GetOkExists("token_num_uses"); ok {
 			data["token_num_uses"] = v.(int)
 		}
 	} else {
@@ -267,11 +322,47 @@ func updateTokenFields(d *schema.ResourceData, data map[string]interface{}, crea
 }
 
 func readTokenFields(d *schema.ResourceData, resp *api.Secret) error {
-	for _, k := range []string{"token_bound_cidrs", "token_explicit_max_ttl", "token_max_ttl", "token_no_default_policy", "token_period", "token_policies", "token_type", "token_ttl", "token_num_uses"} {
-		if err := d.Set(k, resp.Data[k]); err != nil {
+	for _, k := range commonTokenFields() {
+		v, ok := resp.Data[k]
+		if !ok {
+			// Older Vault versions don't return every token field, keep
+			// the configured value rather than producing a diff.
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
 			return fmt.Errorf("error setting state key \"%s\": %s", k, err)
 		}
 	}
 
 	return nil
 }
+
+// normalizeTokenBoundCIDR returns single address CIDRs as the bare address,
+// which is how Vault returns them, e.g. "10.0.0.1/32" becomes "10.0.0.1".
+// Other CIDRs are returned in their canonical form, e.g. "10.0.0.1/8"
+// becomes "10.0.0.0/8".
+func normalizeTokenBoundCIDR(v string) string {
+	ip, ipNet, err := net.ParseCIDR(v)
+	if err != nil {
+		return v
+	}
+	if ones, bits := ipNet.Mask.Size(); ones != bits {
+		return ipNet.String()
+	}
+	return ip.String()
+}
+
+// hashTokenBoundCIDR hashes a CIDR set element by its normalized form, so
+// that equivalent notations of the same block are the same element.
+func hashTokenBoundCIDR(v interface{}) int {
+	return schema.HashString(normalizeTokenBoundCIDR(v.(string)))
+}
+
+// suppressEquivalentCIDRDiff suppresses the diff of a CIDR set element when
+// the old and new notations describe the same block of addresses.
+func suppressEquivalentCIDRDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".#") {
+		return false
+	}
+	return normalizeTokenBoundCIDR(old) == normalizeTokenBoundCIDR(new)
+}
@@ -0,0 +1,194 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestAccTokenFields_import checks that every token field survives an import
+// across role types, so that the first plan after importing is empty.
+func TestAccTokenFields_import(t *testing.T) {
+	suffix := acctest.RandomWithPrefix("token-fields")
+	config := testAccTokenFieldsConfig(suffix)
+
+	steps := []resource.TestStep{
+		{
+			Config: config,
+		},
+	}
+	for _, name := range []string{
+		"vault_approle_auth_backend_role.role",
+		"vault_kubernetes_auth_backend_role.role",
+		"vault_token_auth_backend_role.role",
+	} {
+		steps = append(steps, resource.TestStep{
+			ResourceName:      name,
+			ImportState:       true,
+			ImportStateVerify: true,
+		})
+	}
+	steps = append(steps, resource.TestStep{
+		Config:   config,
+		PlanOnly: true,
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps:     steps,
+	})
+}
+
+func TestTokenPeriodWarning(t *testing.T) {
+	tests := map[string]struct {
+		raw      map[string]interface{}
+		warnings int
+	}{
+		"period only": {
+			raw:      map[string]interface{}{"token_period": 3600, "token_ttl": 600},
+			warnings: 0,
+		},
+		"max ttl only": {
+			raw:      map[string]interface{}{"token_max_ttl": 7200},
+			warnings: 0,
+		},
+		"period and max ttl": {
+			raw:      map[string]interface{}{"token_period": 3600, "token_max_ttl": 7200},
+			warnings: 1,
+		},
+		"deprecated period and explicit max ttl": {
+			raw:      map[string]interface{}{"period": 3600, "token_explicit_max_ttl": 7200},
+			warnings: 1,
+		},
+	}
+
+	write := func(*schema.ResourceData, interface{}) error { return nil }
+	f := tokenPeriodWarning(write, []string{"token_period", "period"}, []string{"token_max_ttl", "token_explicit_max_ttl"})
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, approleAuthBackendRoleResource().Schema, tc.raw)
+			diags := f(context.Background(), d, nil)
+			if diags.HasError() {
+				t.Fatalf("expected no error, got %v", diags)
+			}
+			if len(diags) != tc.warnings {
+				t.Fatalf("expected %d warnings, got %v", tc.warnings, diags)
+			}
+			for _, w := range diags {
+				if w.Severity != diag.Warning {
+					t.Errorf("expected a warning, got %v", w)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeTokenBoundCIDR(t *testing.T) {
+	tests := map[string]string{
+		"10.0.0.1/32":       "10.0.0.1",
+		"10.0.0.1":          "10.0.0.1",
+		"10.0.0.0/16":       "10.0.0.0/16",
+		"10.0.0.1/8":        "10.0.0.0/8",
+		"2001:db8::1/128":   "2001:db8::1",
+		"2001:db8::/32":     "2001:db8::/32",
+		"not-an-ip-address": "not-an-ip-address",
+	}
+
+	for v, want := range tests {
+		if got := normalizeTokenBoundCIDR(v); got != want {
+			t.Errorf("normalizeTokenBoundCIDR(%q) = %q, want %q", v, got, want)
+		}
+	}
+}
+
+func TestSuppressEquivalentCIDRDiff(t *testing.T) {
+	tests := map[string]struct {
+		k    string
+		old  string
+		new  string
+		want bool
+	}{
+		"same block": {
+			k:    "token_bound_cidrs.1234",
+			old:  "10.0.0.0/8",
+			new:  "10.0.0.1/8",
+			want: true,
+		},
+		"single address": {
+			k:    "token_bound_cidrs.1234",
+			old:  "10.0.0.1",
+			new:  "10.0.0.1/32",
+			want: true,
+		},
+		"different block": {
+			k:    "token_bound_cidrs.1234",
+			old:  "10.0.0.0/8",
+			new:  "10.0.0.0/16",
+			want: false,
+		},
+		"count": {
+			k:    "token_bound_cidrs.#",
+			old:  "1",
+			new:  "1",
+			want: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := suppressEquivalentCIDRDiff(tc.k, tc.old, tc.new, nil); got != tc.want {
+				t.Errorf("suppressEquivalentCIDRDiff(%q, %q, %q) = %t, want %t", tc.k, tc.old, tc.new, got, tc.want)
+			}
+		})
+	}
+}
+
+func testAccTokenFieldsConfig(suffix string) string {
+	tokenFields := `
+  token_bound_cidrs       = ["10.0.0.1/32", "10.1.0.0/16"]
+  token_explicit_max_ttl  = 14400
+  token_max_ttl           = 7200
+  token_no_default_policy = true
+  token_num_uses          = 5
+  token_policies          = ["dev", "prod"]
+  token_ttl               = 3600
+  token_type              = "service"`
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "approle-%[1]s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%[1]s"
+%[2]s
+}
+
+resource "vault_auth_backend" "kubernetes" {
+  type = "kubernetes"
+  path = "kubernetes-%[1]s"
+}
+
+resource "vault_kubernetes_auth_backend_role" "role" {
+  backend                          = vault_auth_backend.kubernetes.path
+  role_name                        = "%[1]s"
+  bound_service_account_names      = ["example"]
+  bound_service_account_namespaces = ["example"]
+%[2]s
+}
+
+resource "vault_token_auth_backend_role" "role" {
+  role_name = "%[1]s"
+%[2]s
+}
+`, suffix, tokenFields)
+}
@@ -254,11 +254,10 @@ func identityEntityDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	aliases, ok := resp.Data["aliases"]
-	if ok && aliases != nil {
-		rawAliases := aliases.([]interface{})
-		transformed := schema.NewSet(schema.HashResource(&schema.Resource{Schema: identityEntityAliasSchema}), []interface{}{})
-
+	// An entity without aliases is reported as an empty set rather than
+	// leaving the attribute unknown.
+	transformed := schema.NewSet(schema.HashResource(&schema.Resource{Schema: identityEntityAliasSchema}), []interface{}{})
+	if rawAliases, ok := resp.Data["aliases"].([]interface{}); ok {
 		for _, alias := range rawAliases {
 			alias := alias.(map[string]interface{})
 			data = make(map[string]interface{})
@@ -267,7 +266,9 @@ func identityEntityDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 			}
 			transformed.Add(data)
 		}
-		d.Set("aliases", transformed)
+	}
+	if err := d.Set("aliases", transformed); err != nil {
+		return fmt.Errorf("error setting state key aliases for IdentityEntity: %s", err)
 	}
 
 	// Ignoring error because this value came from JSON in the
@@ -54,6 +54,37 @@ func TestDataSourceIdentityEntityAlias(t *testing.T) {
 	})
 }
 
+func TestDataSourceIdentityEntityAliases(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	dataName := "data.vault_identity_entity.entity"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityEntity_configAliasesByID(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testDataSourceIdentityEntity_check(),
+					resource.TestCheckResourceAttr(dataName, "aliases.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataName, "aliases.*", map[string]string{
+						"name":       entity,
+						"mount_type": "github",
+						"mount_path": "auth/github-" + entity + "/",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataName, "aliases.*.mount_accessor",
+						"vault_auth_backend.github", "accessor"),
+					resource.TestCheckTypeSetElemAttrPair(dataName, "aliases.*.id",
+						"vault_identity_entity_alias.entity_alias", "id"),
+					resource.TestCheckResourceAttr(dataName, "group_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataName, "group_ids.*",
+						"vault_identity_group.group", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testDataSourceIdentityEntity_check() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["data.vault_identity_entity.entity"]
@@ -207,3 +238,34 @@ data "vault_identity_entity" "entity" {
 }
 `, entityName, entityName, entityName)
 }
+
+func testDataSourceIdentityEntity_configAliasesByID(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name     = "%s"
+  policies = ["test"]
+}
+
+resource "vault_identity_group" "group" {
+  name              = "%s"
+  member_entity_ids = [vault_identity_entity.entity.id]
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_entity_alias" "entity_alias" {
+  name           = "%s"
+  mount_accessor = vault_auth_backend.github.accessor
+  canonical_id   = vault_identity_entity.entity.id
+}
+
+data "vault_identity_entity" "entity" {
+  entity_id = vault_identity_entity_alias.entity_alias.canonical_id
+
+  depends_on = [vault_identity_group.group]
+}
+`, entityName, entityName, entityName, entityName)
+}
@@ -0,0 +1,137 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcIntrospectPath = "identity/oidc/introspect"
+
+func identityOidcIntrospectDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityOidcIntrospectDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The signed OIDC identity token to introspect.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Client ID to verify the token's audience against.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the token is valid and active.",
+			},
+			"error": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason the token is not active, if any.",
+			},
+			"claims": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Claims of an active token. Non-string values are JSON encoded.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityOidcIntrospectDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	token := d.Get("token").(string)
+	body := map[string]interface{}{
+		"token": token,
+	}
+	if v, ok := d.GetOk("client_id"); ok {
+		body["client_id"] = v.(string)
+	}
+
+	// The introspect endpoint responds with a raw JSON body rather than a
+	// regular secret, so it cannot be read through client.Logical().
+	r := client.NewRequest("POST", "/v1/"+identityOidcIntrospectPath)
+	if err := r.SetJSONBody(body); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Introspecting OIDC token at %q", identityOidcIntrospectPath)
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error introspecting OIDC token at %q: %s", identityOidcIntrospectPath, err)
+	}
+	log.Printf("[DEBUG] Introspected OIDC token at %q", identityOidcIntrospectPath)
+
+	var result struct {
+		Active bool   `json:"active"`
+		Error  string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("error decoding OIDC introspection response: %s", err)
+	}
+
+	d.SetId(identityOidcIntrospectPath)
+	d.Set("active", result.Active)
+	d.Set("error", result.Error)
+
+	claims := map[string]string{}
+	if result.Active {
+		claims, err = identityOidcTokenClaims(token)
+		if err != nil {
+			return err
+		}
+	}
+	if err := d.Set("claims", claims); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// identityOidcTokenClaims decodes the payload of a signed JWT. It does not
+// verify the signature, which is left to Vault's introspect endpoint.
+func identityOidcTokenClaims(token string) (map[string]string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("OIDC token is not a signed JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding OIDC token payload: %s", err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(payload, &raw); err != nil {
+		return nil, fmt.Errorf("error decoding OIDC token claims: %s", err)
+	}
+
+	claims := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if vs, ok := v.(string); ok {
+			claims[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			claims[k] = string(vBytes)
+		}
+	}
+
+	return claims, nil
+}
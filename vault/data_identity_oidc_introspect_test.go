@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"encoding/base64"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceIdentityOidcIntrospect_inactive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_identity_oidc_introspect" "token" {
+  token = "not.a.token"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_identity_oidc_introspect.token", "active", "false"),
+					resource.TestMatchResourceAttr("data.vault_identity_oidc_introspect.token", "error", regexp.MustCompile(".+")),
+					resource.TestCheckResourceAttr("data.vault_identity_oidc_introspect.token", "claims.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestIdentityOidcTokenClaims(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"entity-id","iat":1600000000,"groups":["a","b"]}`))
+
+	tests := []struct {
+		name    string
+		token   string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			token: "header." + payload + ".signature",
+			want: map[string]string{
+				"sub":    "entity-id",
+				"iat":    "1600000000",
+				"groups": `["a","b"]`,
+			},
+		},
+		{
+			name:    "not-a-jwt",
+			token:   "foo",
+			wantErr: true,
+		},
+		{
+			name:    "bad-payload",
+			token:   "header.!!!.signature",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := identityOidcTokenClaims(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("identityOidcTokenClaims() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("identityOidcTokenClaims() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -53,11 +53,16 @@ func approleAuthBackendRoleIDRead(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Read AppRole auth backend role %q RoleID", path)
 
 	if resp == nil {
-		d.SetId("")
-		return nil
+		return fmt.Errorf("AppRole auth backend role %q not found", path)
 	}
+
+	roleID, ok := resp.Data["role_id"].(string)
+	if !ok {
+		return fmt.Errorf("no RoleID found for AppRole auth backend role %q", path)
+	}
+
 	d.SetId(path + "/role-id")
-	d.Set("role_id", resp.Data["role_id"])
+	d.Set("role_id", roleID)
 
 	return nil
 }
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -79,6 +80,31 @@ func TestAccAppRoleAuthBackendRoleID_customID(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRoleID_missingRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+data "vault_approle_auth_backend_role_id" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}`, backend, role),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`AppRole auth backend role "auth/%s/role/%s" not found`, backend, role)),
+			},
+		},
+	})
+}
+
 func testAccAppRoleAuthBackendRoleIDConfig_basic(backend, role string) string {
 	return fmt.Sprintf(`
 %s
@@ -83,12 +83,14 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 			"secret_key": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "AWS secret key read from Vault.",
 			},
 
 			"security_token": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "AWS security token read from Vault. (Only returned if type is 'sts').",
 			},
 
@@ -153,17 +155,20 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("no role found at path %q", path)
 	}
 
-	accessKey := secret.Data["access_key"].(string)
-	secretKey := secret.Data["secret_key"].(string)
-	var securityToken string
-	if secret.Data["security_token"] != nil {
-		securityToken = secret.Data["security_token"].(string)
+	accessKey, _ := secret.Data["access_key"].(string)
+	if accessKey == "" {
+		return fmt.Errorf("access_key is not set in response")
 	}
+	secretKey, _ := secret.Data["secret_key"].(string)
+	if secretKey == "" {
+		return fmt.Errorf("secret_key is not set in response")
+	}
+	securityToken, _ := secret.Data["security_token"].(string)
 
 	d.SetId(secret.LeaseID)
-	d.Set("access_key", secret.Data["access_key"])
-	d.Set("secret_key", secret.Data["secret_key"])
-	d.Set("security_token", secret.Data["security_token"])
+	d.Set("access_key", accessKey)
+	d.Set("secret_key", secretKey)
+	d.Set("security_token", securityToken)
 	d.Set("lease_id", secret.LeaseID)
 	d.Set("lease_duration", secret.LeaseDuration)
 	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
@@ -61,6 +61,7 @@ func azureAccessCredentialsDataSource() *schema.Resource {
 			"client_secret": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The client secret for credentials to query the Azure APIs.",
 			},
 			"lease_id": {
@@ -106,12 +107,18 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("no role found at credsPath %q", credsPath)
 	}
 
-	clientID := secret.Data["client_id"].(string)
-	clientSecret := secret.Data["client_secret"].(string)
+	clientID, _ := secret.Data["client_id"].(string)
+	if clientID == "" {
+		return fmt.Errorf("client_id is not set in response")
+	}
+	clientSecret, _ := secret.Data["client_secret"].(string)
+	if clientSecret == "" {
+		return fmt.Errorf("client_secret is not set in response")
+	}
 
 	d.SetId(secret.LeaseID)
-	_ = d.Set("client_id", secret.Data["client_id"])
-	_ = d.Set("client_secret", secret.Data["client_secret"])
+	_ = d.Set("client_id", clientID)
+	_ = d.Set("client_secret", clientSecret)
 	_ = d.Set("lease_id", secret.LeaseID)
 	_ = d.Set("lease_duration", secret.LeaseDuration)
 	_ = d.Set("lease_start_time", time.Now().Format(time.RFC3339))
@@ -131,6 +138,10 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 	}
 	log.Printf("[DEBUG] Read %q from Vault", configPath)
 
+	if secret == nil {
+		return fmt.Errorf("no config found at %q, it is required to validate credentials", configPath)
+	}
+
 	subscriptionID := ""
 	if subscriptionIDIfc, ok := secret.Data["subscription_id"]; ok {
 		subscriptionID = subscriptionIDIfc.(string)
@@ -164,7 +175,7 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 	}
 	authorizer, err := config.Authorizer()
 	if err != nil {
-		return nil
+		return fmt.Errorf("error creating Azure authorizer to validate credentials: %s", err)
 	}
 	vnetClient.Authorizer = authorizer
 
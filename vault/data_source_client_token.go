@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const clientTokenLookupPath = "auth/token/lookup-self"
+
+func clientTokenDataSource() *schema.Resource {
+	fields := tokenLookupFields()
+	fields["accessor"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+		Description: "Accessor of the provider's token.",
+	}
+
+	return &schema.Resource{
+		Read:   clientTokenDataSourceRead,
+		Schema: fields,
+	}
+}
+
+func clientTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading provider token from %q", clientTokenLookupPath)
+	resp, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return fmt.Errorf("error reading provider token from %q: %s", clientTokenLookupPath, err)
+	}
+	log.Printf("[DEBUG] Read provider token from %q", clientTokenLookupPath)
+	if resp == nil {
+		return fmt.Errorf("no token found at %q", clientTokenLookupPath)
+	}
+
+	// The accessor is sensitive, so unlike vault_token it is not used as
+	// the ID.
+	d.SetId(clientTokenLookupPath)
+
+	return tokenLookupSet(d, resp)
+}
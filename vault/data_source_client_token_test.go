@@ -0,0 +1,34 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceClientToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceClientTokenConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_client_token.test", "id", "auth/token/lookup-self"),
+					resource.TestCheckResourceAttrPair("data.vault_client_token.test", "accessor",
+						"data.vault_token.self", "accessor"),
+					resource.TestCheckResourceAttrPair("data.vault_client_token.test", "token_policies.#",
+						"data.vault_token.self", "token_policies.#"),
+					resource.TestCheckResourceAttrSet("data.vault_client_token.test", "ttl"),
+					resource.TestCheckResourceAttrSet("data.vault_client_token.test", "display_name"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceClientTokenConfig = `
+data "vault_client_token" "test" {}
+
+data "vault_token" "self" {}
+`
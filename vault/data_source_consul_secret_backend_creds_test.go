@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccDataSourceConsulSecretBackendCreds(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-consul")
+	role := acctest.RandomWithPrefix("tf-test-role")
+	address, token := util.GetTestConsulCreds(t)
+	dataName := "data.vault_consul_secret_backend_creds.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConsulSecretBackendCredsConfig(backend, address, token, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, "token"),
+					resource.TestCheckResourceAttrSet(dataName, "accessor"),
+					resource.TestCheckResourceAttrSet(dataName, "lease_id"),
+					resource.TestCheckResourceAttr(dataName, "lease_duration", "120"),
+					resource.TestCheckResourceAttr(dataName, "local", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceConsulSecretBackendCredsConfig(backend, address, token, role string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path    = "%s"
+  address = "%s"
+  token   = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend  = vault_consul_secret_backend.test.path
+  name     = "%s"
+  ttl      = 120
+  policies = ["global-management"]
+}
+
+data "vault_consul_secret_backend_creds" "test" {
+  backend = vault_consul_secret_backend.test.path
+  role    = vault_consul_secret_backend_role.test.name
+}
+`, backend, address, token, role)
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDatabaseSecretBackendCreds(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	dbName := acctest.RandomWithPrefix("db")
+	role := acctest.RandomWithPrefix("role")
+	dataName := "data.vault_database_secret_backend_creds.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDatabaseSecretBackendCredsConfig(backend, dbName, role, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "backend", backend),
+					resource.TestCheckResourceAttr(dataName, "role", role),
+					resource.TestCheckResourceAttrSet(dataName, "username"),
+					resource.TestCheckResourceAttrSet(dataName, "password"),
+					resource.TestCheckResourceAttrSet(dataName, "lease_id"),
+					resource.TestCheckResourceAttr(dataName, "lease_duration", "3600"),
+					resource.TestCheckResourceAttr(dataName, "lease_renewable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabaseSecretBackendCredsConfig(backend, db, role, connURL string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend       = vault_mount.db.path
+  name          = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+    connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_role" "test" {
+  backend             = vault_mount.db.path
+  db_name             = vault_database_secret_backend_connection.test.name
+  name                = "%s"
+  default_ttl         = 3600
+  max_ttl             = 7200
+  creation_statements = ["CREATE USER '{{name}}'@'%%' IDENTIFIED BY '{{password}}';"]
+}
+
+data "vault_database_secret_backend_creds" "test" {
+  backend = vault_mount.db.path
+  role    = vault_database_secret_backend_role.test.name
+}
+`, backend, db, connURL, role)
+}
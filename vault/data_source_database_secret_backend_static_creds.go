@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func databaseSecretBackendStaticCredsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: databaseSecretBackendStaticCredsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the Database Secret Backend to read credentials from.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the static role to read credentials for.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The database username managed by the static role.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current database password.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last password rotation, in RFC3339 format.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Interval in seconds between password rotations.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Seconds until the password is next rotated.",
+			},
+		},
+	}
+}
+
+func databaseSecretBackendStaticCredsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := fmt.Sprintf("%s/static-creds/%s", backend, role)
+
+	log.Printf("[DEBUG] Reading database static credentials from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading database static credentials from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read database static credentials from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no static role found at %q", path)
+	}
+
+	password, _ := secret.Data["password"].(string)
+	if password == "" {
+		return fmt.Errorf("password is not set in response")
+	}
+
+	d.SetId(path)
+	d.Set("username", secret.Data["username"])
+	d.Set("password", password)
+	d.Set("last_vault_rotation", secret.Data["last_vault_rotation"])
+
+	for _, k := range []string{"rotation_period", "ttl"} {
+		if v, ok := secret.Data[k].(json.Number); ok {
+			i, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for %s in response: %s", v, k, err)
+			}
+			d.Set(k, i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceDatabaseSecretBackendStaticCreds(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+	dataName := "data.vault_database_secret_backend_static_creds.test"
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDatabaseSecretBackendStaticCredsConfig(name, username, dbName, backend, connURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "username", username),
+					resource.TestCheckResourceAttr(dataName, "rotation_period", "3600"),
+					resource.TestCheckResourceAttrSet(dataName, "password"),
+					resource.TestCheckResourceAttrSet(dataName, "last_vault_rotation"),
+					resource.TestCheckResourceAttrSet(dataName, "ttl"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceDatabaseSecretBackendStaticCredsConfig(name, username, db, path, connURL string) string {
+	return testAccDatabaseSecretBackendStaticRoleConfig_basic(name, username, db, path, connURL) + `
+data "vault_database_secret_backend_static_creds" "test" {
+  backend = vault_mount.db.path
+  role    = vault_database_secret_backend_static_role.test.name
+}
+`
+}
@@ -146,7 +146,9 @@ func gcpAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretBackendRolesetKeyDataSource() *schema.Resource {
+	return gcpSecretBackendKeyDataSource("roleset")
+}
+
+func gcpSecretBackendStaticAccountKeyDataSource() *schema.Resource {
+	return gcpSecretBackendKeyDataSource("static_account")
+}
+
+// gcpSecretBackendKeyDataSource returns a data source generating service
+// account keys for either a roleset or a static account, selected by field.
+func gcpSecretBackendKeyDataSource(field string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return gcpSecretBackendKeyDataSourceRead(d, meta, field)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP Secrets Engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			field: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s to generate a service account key for.", strings.Replace(field, "_", " ", -1)),
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "KEY_ALG_RSA_2048",
+				Description:  "Key algorithm used to generate the key.",
+				ValidateFunc: validation.StringInSlice([]string{"KEY_ALG_RSA_1024", "KEY_ALG_RSA_2048"}, false),
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "TYPE_GOOGLE_CREDENTIALS_FILE",
+				Description:  "Private key type to generate.",
+				ValidateFunc: validation.StringInSlice([]string{"TYPE_PKCS12_FILE", "TYPE_GOOGLE_CREDENTIALS_FILE"}, false),
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Time-To-Live of the key lease. Uses the mount's default when not specified.",
+			},
+			"private_key_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64-encoded private key data of the generated key.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func gcpSecretBackendKeyDataSourceRead(d *schema.ResourceData, meta interface{}, field string) error {
+	client := meta.(*api.Client)
+
+	path := gcpSecretBackendCredentialPath(d.Get("backend").(string), field, d.Get(field).(string), "key")
+
+	data := map[string][]string{
+		"key_algorithm": {d.Get("key_algorithm").(string)},
+		"key_type":      {d.Get("key_type").(string)},
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = []string{v.(string)}
+	}
+
+	log.Printf("[DEBUG] Generating GCP service account key from %q", path)
+	secret, err := client.Logical().ReadWithData(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating GCP service account key from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated GCP service account key from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no %s found at %q", field, path)
+	}
+
+	privateKeyData, _ := secret.Data["private_key_data"].(string)
+	if privateKeyData == "" {
+		return fmt.Errorf("private_key_data is not set in response")
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("private_key_data", privateKeyData)
+
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceGCPSecretBackendRolesetKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	roleset := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	dataName := "data.vault_gcp_secret_backend_roleset_key.test"
+
+	config, _ := testGCPSecretRoleset_service_account_key(backend, roleset, credentials, project, "roles/viewer")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+data "vault_gcp_secret_backend_roleset_key" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = vault_gcp_secret_roleset.test.roleset
+  ttl     = "1h"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "key_algorithm", "KEY_ALG_RSA_2048"),
+					resource.TestCheckResourceAttr(dataName, "key_type", "TYPE_GOOGLE_CREDENTIALS_FILE"),
+					resource.TestCheckResourceAttrSet(dataName, "private_key_data"),
+					resource.TestCheckResourceAttrSet(dataName, "lease_id"),
+					resource.TestCheckResourceAttr(dataName, "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretBackendRolesetTokenDataSource() *schema.Resource {
+	return gcpSecretBackendTokenDataSource("roleset")
+}
+
+func gcpSecretBackendStaticAccountTokenDataSource() *schema.Resource {
+	return gcpSecretBackendTokenDataSource("static_account")
+}
+
+// gcpSecretBackendTokenDataSource returns a data source generating OAuth2
+// access tokens for either a roleset or a static account, selected by field.
+func gcpSecretBackendTokenDataSource(field string) *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return gcpSecretBackendTokenDataSourceRead(d, meta, field)
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the GCP Secrets Engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			field: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: fmt.Sprintf("Name of the %s to generate an access token for.", strings.Replace(field, "_", " ", -1)),
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth2 access token.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Unix time at which the access token expires.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Remaining lifetime of the access token in seconds.",
+			},
+		},
+	}
+}
+
+func gcpSecretBackendTokenDataSourceRead(d *schema.ResourceData, meta interface{}, field string) error {
+	client := meta.(*api.Client)
+
+	path := gcpSecretBackendCredentialPath(d.Get("backend").(string), field, d.Get(field).(string), "token")
+
+	log.Printf("[DEBUG] Generating GCP access token from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating GCP access token from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated GCP access token from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no %s found at %q", field, path)
+	}
+
+	token, _ := secret.Data["token"].(string)
+	if token == "" {
+		return fmt.Errorf("token is not set in response")
+	}
+
+	d.SetId(path)
+	d.Set("token", token)
+	for _, k := range []string{"expires_at_seconds", "token_ttl"} {
+		if v, ok := secret.Data[k].(json.Number); ok {
+			i, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for %s in response: %s", v, k, err)
+			}
+			d.Set(k, i)
+		}
+	}
+
+	return nil
+}
+
+// gcpSecretBackendCredentialPath returns the path generating credentials of
+// the given kind, either "token" or "key". Rolesets use the older top level
+// endpoints so that Vault versions without static accounts are supported.
+func gcpSecretBackendCredentialPath(backend, field, name, kind string) string {
+	if field == "static_account" {
+		return fmt.Sprintf("%s/static-account/%s/%s", backend, name, kind)
+	}
+	return fmt.Sprintf("%s/%s/%s", backend, kind, name)
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceGCPSecretBackendRolesetToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	roleset := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+	dataName := "data.vault_gcp_secret_backend_roleset_token.test"
+
+	config, _ := testGCPSecretRoleset_access_token(backend, roleset, credentials, project, "roles/viewer")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+data "vault_gcp_secret_backend_roleset_token" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = vault_gcp_secret_roleset.test.roleset
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "id", backend+"/token/"+roleset),
+					resource.TestCheckResourceAttrSet(dataName, "token"),
+					resource.TestCheckResourceAttrSet(dataName, "expires_at_seconds"),
+					resource.TestCheckResourceAttrSet(dataName, "token_ttl"),
+				),
+			},
+		},
+	})
+}
+
+func TestGCPSecretBackendCredentialPath(t *testing.T) {
+	tests := []struct {
+		field, name, kind, want string
+	}{
+		{"roleset", "ci", "token", "gcp/token/ci"},
+		{"roleset", "ci", "key", "gcp/key/ci"},
+		{"static_account", "ci", "token", "gcp/static-account/ci/token"},
+		{"static_account", "ci", "key", "gcp/static-account/ci/key"},
+	}
+	for _, tt := range tests {
+		if got := gcpSecretBackendCredentialPath("gcp", tt.field, tt.name, tt.kind); got != tt.want {
+			t.Errorf("gcpSecretBackendCredentialPath(%q, %q, %q) = %q, want %q", tt.field, tt.name, tt.kind, got, tt.want)
+		}
+	}
+}
@@ -29,6 +29,8 @@ func genericSecretDataSource() *schema.Resource {
 				Default:  latestSecretVersion,
 			},
 
+			"kv_version": kvVersionSchema(),
+
 			"data_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -78,7 +80,7 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	secretVersion := d.Get("version").(int)
 	log.Printf("[DEBUG] Reading %s %d from Vault", path, secretVersion)
 
-	secret, err := versionedSecret(secretVersion, path, client)
+	secret, err := versionedSecret(secretVersion, path, client, d.Get("kv_version").(int))
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const keyStatusPath = "sys/key-status"
+
+func keyStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: keyStatusDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"term": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The sequential key number of the current barrier encryption key.",
+			},
+			"install_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the current key was installed, in RFC3339 format.",
+			},
+			"encryptions": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of encryptions performed with the current key.",
+			},
+		},
+	}
+}
+
+func keyStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading key status from %q", keyStatusPath)
+	status, err := client.Sys().KeyStatus()
+	if err != nil {
+		if util.ErrorContainsHTTPCode(err, http.StatusForbidden) {
+			return fmt.Errorf("permission denied reading %q, the token requires the read capability on that path: %s", keyStatusPath, err)
+		}
+		return fmt.Errorf("error reading key status from %q: %s", keyStatusPath, err)
+	}
+	log.Printf("[DEBUG] Read key status from %q", keyStatusPath)
+
+	d.SetId(keyStatusPath)
+	d.Set("term", status.Term)
+	d.Set("install_time", status.InstallTime.Format(time.RFC3339))
+	d.Set("encryptions", status.Encryptions)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceKeyStatus(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_key_status" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_key_status.test", "id", keyStatusPath),
+					resource.TestCheckResourceAttrSet("data.vault_key_status.test", "term"),
+					resource.TestCheckResourceAttrSet("data.vault_key_status.test", "install_time"),
+				),
+			},
+		},
+	})
+}
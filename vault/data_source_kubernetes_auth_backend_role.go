@@ -120,7 +120,9 @@ func kubernetesAuthBackendRoleDataSourceRead(d *schema.ResourceData, meta interf
 	}
 	d.SetId(path)
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	for _, k := range []string{"bound_cidrs", "bound_service_account_names", "bound_service_account_namespaces", "num_uses", "policies", "ttl", "max_ttl", "period", "audience"} {
 		d.Set(k, resp.Data[k])
@@ -0,0 +1,139 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const licenseStatusPath = "sys/license/status"
+
+func licenseDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: licenseDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"licensed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "False if the server has no license, e.g. because it is Vault OSS.",
+			},
+			"autoloading_used": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the active license was autoloaded from the server's configuration.",
+			},
+			"license_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the active license.",
+			},
+			"customer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the customer the license was issued to.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license became valid.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license expires.",
+			},
+			"termination_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time after which Vault stops operating under the license.",
+			},
+			"terminated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the license's termination time has passed.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Features enabled by the license.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"performance_standby_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of performance standby nodes allowed by the license.",
+			},
+		},
+	}
+}
+
+func licenseDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading license status from %q", licenseStatusPath)
+	resp, err := client.Logical().Read(licenseStatusPath)
+	if err != nil && !util.Is404(err) {
+		if util.ErrorContainsHTTPCode(err, http.StatusForbidden) {
+			return fmt.Errorf("permission denied reading %q, the token requires the read capability on that path: %s", licenseStatusPath, err)
+		}
+		return fmt.Errorf("error reading license status from %q: %s", licenseStatusPath, err)
+	}
+	log.Printf("[DEBUG] Read license status from %q", licenseStatusPath)
+
+	d.SetId(licenseStatusPath)
+
+	// Vault OSS does not serve the license endpoints at all.
+	if err != nil || resp == nil {
+		log.Printf("[WARN] No license found at %q", licenseStatusPath)
+		d.Set("licensed", false)
+		return nil
+	}
+
+	license, _ := resp.Data["autoloaded"].(map[string]interface{})
+	if license == nil {
+		license, _ = resp.Data["stored"].(map[string]interface{})
+	}
+	if license == nil {
+		d.Set("licensed", false)
+		return nil
+	}
+
+	d.Set("licensed", true)
+	d.Set("autoloading_used", resp.Data["autoloading_used"])
+	for _, k := range []string{"license_id", "customer_id", "start_time", "expiration_time", "termination_time", "features", "performance_standby_count"} {
+		if err := d.Set(k, license[k]); err != nil {
+			return fmt.Errorf("error setting state key %q from %q: %s", k, licenseStatusPath, err)
+		}
+	}
+
+	terminated, err := licenseTerminated(license["termination_time"], time.Now())
+	if err != nil {
+		return err
+	}
+	d.Set("terminated", terminated)
+
+	return nil
+}
+
+// licenseTerminated reports whether the given termination time has passed.
+func licenseTerminated(terminationTime interface{}, now time.Time) (bool, error) {
+	v, _ := terminationTime.(string)
+	if v == "" {
+		return false, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return false, fmt.Errorf("error parsing license termination_time %q: %s", v, err)
+	}
+
+	return now.After(t), nil
+}
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceLicense(t *testing.T) {
+	licensed := "true"
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		licensed = "false"
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `data "vault_license" "test" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_license.test", "id", licenseStatusPath),
+					resource.TestCheckResourceAttr("data.vault_license.test", "licensed", licensed),
+				),
+			},
+		},
+	})
+}
+
+func TestLicenseTerminated(t *testing.T) {
+	now := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		terminationTime interface{}
+		want            bool
+		wantErr         bool
+	}{
+		{
+			name:            "unset",
+			terminationTime: nil,
+		},
+		{
+			name:            "future",
+			terminationTime: "2022-01-01T00:00:00Z",
+		},
+		{
+			name:            "past",
+			terminationTime: "2021-01-01T00:00:00Z",
+			want:            true,
+		},
+		{
+			name:            "invalid",
+			terminationTime: "tomorrow",
+			wantErr:         true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := licenseTerminated(tt.terminationTime, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("licenseTerminated() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("licenseTerminated() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func mountConfigDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: mountConfigDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the secret backend is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "config",
+				Description: "Path of the config endpoint relative to the mount.",
+			},
+			"exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the mount exposes a readable config endpoint at config_path.",
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded config data read from Vault.",
+				Sensitive:   true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func mountConfigDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	path := mount + "/" + strings.Trim(d.Get("config_path").(string), "/")
+
+	log.Printf("[DEBUG] Reading mount config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil && !util.Is404(err) && !strings.Contains(err.Error(), "Code: 405") {
+		return fmt.Errorf("error reading mount config from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read mount config from %q", path)
+
+	d.SetId(path)
+
+	data := map[string]interface{}{}
+	if err != nil || resp == nil {
+		// Not every engine exposes a config endpoint, report an empty
+		// config rather than failing the whole plan.
+		log.Printf("[WARN] No config found at %q", path)
+		d.Set("exists", false)
+	} else {
+		d.Set("exists", true)
+		if resp.Data != nil {
+			data = resp.Data
+		}
+	}
+
+	// Ignoring error because this value came from JSON in the
+	// first place so no reason why it should fail to re-encode.
+	jsonDataBytes, _ := json.Marshal(data)
+	d.Set("data_json", string(jsonDataBytes))
+
+	dataMap := map[string]string{}
+	for k, v := range data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+	d.Set("data", dataMap)
+
+	return nil
+}
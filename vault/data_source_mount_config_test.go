@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceMountConfig(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kvv2")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMountConfig_config(mount, "config"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_mount_config.test", "id", mount+"/config"),
+					resource.TestCheckResourceAttr("data.vault_mount_config.test", "exists", "true"),
+					resource.TestCheckResourceAttr("data.vault_mount_config.test", "data.cas_required", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_mount_config.test", "data.max_versions"),
+				),
+			},
+			{
+				Config: testDataSourceMountConfig_config(mount, "config/unknown"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_mount_config.test", "exists", "false"),
+					resource.TestCheckResourceAttr("data.vault_mount_config.test", "data.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceMountConfig_config(mount, configPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path    = "%s"
+  type    = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+data "vault_mount_config" "test" {
+  mount       = vault_mount.test.path
+  config_path = "%s"
+}
+`, mount, configPath)
+}
@@ -0,0 +1,162 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// mountsSystemTypes are the mounts every Vault server, and in Vault
+// Enterprise every namespace, has and which cannot be managed.
+var mountsSystemTypes = map[string]bool{
+	"cubbyhole":    true,
+	"identity":     true,
+	"system":       true,
+	"ns_cubbyhole": true,
+	"ns_identity":  true,
+	"ns_system":    true,
+}
+
+func mountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: mountsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return mounts of this secrets engine type.",
+			},
+			"include_system": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include the cubbyhole, identity and sys mounts.",
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Sorted paths of the matching mounts.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"mounts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching mounts, sorted by path.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Path of the mount, without a trailing slash.",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Type of the secrets engine.",
+						},
+						"accessor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Accessor of the mount.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human-friendly description of the mount.",
+						},
+						"local": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the mount is not replicated.",
+						},
+						"seal_wrap": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if seal wrapping is enabled for the mount.",
+						},
+						"default_lease_ttl_seconds": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Default lease duration in seconds.",
+						},
+						"max_lease_ttl_seconds": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Maximum possible lease duration in seconds.",
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Options of the secrets engine, e.g. the KV version.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func mountsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	filterType := d.Get("type").(string)
+	includeSystem := d.Get("include_system").(bool)
+
+	log.Printf("[DEBUG] Listing mounts")
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error listing mounts: %s", err)
+	}
+	log.Printf("[DEBUG] Listed %d mounts", len(mounts))
+
+	paths := make([]string, 0, len(mounts))
+	for path, mount := range mounts {
+		if filterType != "" && mount.Type != filterType {
+			continue
+		}
+		if !includeSystem && mountsSystemTypes[mount.Type] {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	trimmedPaths := make([]string, 0, len(paths))
+	result := make([]map[string]interface{}, 0, len(paths))
+	for _, path := range paths {
+		mount := mounts[path]
+		path = strings.TrimSuffix(path, "/")
+		trimmedPaths = append(trimmedPaths, path)
+		result = append(result, map[string]interface{}{
+			"path":                      path,
+			"type":                      mount.Type,
+			"accessor":                  mount.Accessor,
+			"description":               mount.Description,
+			"local":                     mount.Local,
+			"seal_wrap":                 mount.SealWrap,
+			"default_lease_ttl_seconds": mount.Config.DefaultLeaseTTL,
+			"max_lease_ttl_seconds":     mount.Config.MaxLeaseTTL,
+			"options":                   mount.Options,
+		})
+	}
+
+	d.SetId("sys/mounts")
+	if err := d.Set("paths", trimmedPaths); err != nil {
+		return err
+	}
+	if err := d.Set("mounts", result); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDataSourceMounts(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf-test-mounts")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMountsConfig(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "paths.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "paths.0", prefix+"-a"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "paths.1", prefix+"-b"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "mounts.0.path", prefix+"-a"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "mounts.0.type", "transit"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "mounts.0.description", "a"),
+					resource.TestCheckResourceAttrSet("data.vault_mounts.transit", "mounts.0.accessor"),
+					resource.TestCheckResourceAttr("data.vault_mounts.transit", "mounts.1.path", prefix+"-b"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vault_mounts.all", "mounts.*", map[string]string{
+						"path":            prefix + "-kv",
+						"type":            "kv",
+						"options.version": "2",
+					}),
+					testDataSourceMountsCheckType("data.vault_mounts.all", "system", false),
+					testDataSourceMountsCheckType("data.vault_mounts.system", "system", true),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceMountsCheckType(n, mountType string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		found := false
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "mounts.") && strings.HasSuffix(k, ".type") && v == mountType {
+				found = true
+			}
+		}
+		if found != expected {
+			return fmt.Errorf("expected mount of type %q in %s to be %t, got %t", mountType, n, expected, found)
+		}
+		return nil
+	}
+}
+
+func testDataSourceMountsConfig(prefix string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "b" {
+  path        = "%[1]s-b"
+  type        = "transit"
+  description = "b"
+}
+
+resource "vault_mount" "a" {
+  path        = "%[1]s-a"
+  type        = "transit"
+  description = "a"
+}
+
+resource "vault_mount" "kv" {
+  path    = "%[1]s-kv"
+  type    = "kv"
+  options = { version = "2" }
+}
+
+data "vault_mounts" "transit" {
+  type = "transit"
+
+  depends_on = [vault_mount.a, vault_mount.b]
+}
+
+data "vault_mounts" "all" {
+  depends_on = [vault_mount.a, vault_mount.b, vault_mount.kv]
+}
+
+data "vault_mounts" "system" {
+  include_system = true
+}
+`, prefix)
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendCertDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendCertDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PKI secret backend to issue the certificate from.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the role to issue the certificate against.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CN of the certificate to issue.",
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name or ID of the issuer to issue the certificate with. Defaults to the role's issuer.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alternative names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ip_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alternative IPs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"uri_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of alternative URIs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"other_sans": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of other SANs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Time to live.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The format of data.",
+				Default:      "pem",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "der", "pem_bundle"}, false),
+			},
+			"private_key_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The private key format.",
+				Default:      "der",
+				ValidateFunc: validation.StringInSlice([]string{"der", "pkcs8"}, false),
+			},
+			"exclude_cn_from_sans": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Flag to exclude CN from SANs.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The certificate.",
+			},
+			"issuing_ca": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuing CA.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CA chain.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key.",
+				Sensitive:   true,
+			},
+			"private_key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The private key type.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number.",
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The certificate expiration.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	commonName := d.Get("common_name").(string)
+
+	path := pkiSecretBackendCertPath(backend, name)
+	if v, ok := d.GetOk("issuer_ref"); ok {
+		path = strings.Trim(backend, "/") + "/issuer/" + v.(string) + "/issue/" + strings.Trim(name, "/")
+	}
+
+	data := pkiSecretBackendCertRequestData(d)
+
+	log.Printf("[DEBUG] Issuing certificate %s by %s from %q", commonName, name, path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error issuing certificate %s by %s from %q: %s", commonName, name, path, err)
+	}
+	log.Printf("[DEBUG] Issued certificate %s by %s from %q", commonName, name, path)
+
+	if resp == nil {
+		return fmt.Errorf("no certificate issued from %q", path)
+	}
+
+	pkiSecretBackendCertSetResponse(d, resp)
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", backend, name, resp.Data["serial_number"]))
+	return nil
+}
@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourcePkiSecretBackendCert(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	dataName := "data.vault_pki_secret_backend_cert.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePkiSecretBackendCertConfig(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataName, "backend", rootPath),
+					resource.TestCheckResourceAttr(dataName, "common_name", "cert.test.my.domain"),
+					resource.TestCheckResourceAttrSet(dataName, "certificate"),
+					resource.TestCheckResourceAttrSet(dataName, "issuing_ca"),
+					resource.TestCheckResourceAttrSet(dataName, "private_key"),
+					resource.TestCheckResourceAttr(dataName, "private_key_type", "rsa"),
+					resource.TestCheckResourceAttrSet(dataName, "serial_number"),
+					resource.TestCheckResourceAttrSet(dataName, "expiration"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePkiSecretBackendCertConfig(rootPath string) string {
+	return testPkiSecretBackendCertConfig_renew(rootPath) + `
+data "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_pki_secret_backend.test-root.path
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "cert.test.my.domain"
+  ttl         = "1h"
+}
+`
+}
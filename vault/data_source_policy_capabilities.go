@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/helper"
+	"github.com/hashicorp/vault/api"
+)
+
+func policyCapabilitiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: policyCapabilitiesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Policies to evaluate the paths against.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"no_default_policy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Evaluate the paths without the default policy.",
+			},
+			"paths": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Paths to report the capabilities of.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"capabilities": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The capabilities granted on each path, in the order of paths.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The evaluated path.",
+						},
+						"capabilities": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Sorted capabilities granted on the path.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func policyCapabilitiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var policies []string
+	for _, v := range d.Get("policies").(*schema.Set).List() {
+		policies = append(policies, v.(string))
+	}
+	sort.Strings(policies)
+	noDefaultPolicy := d.Get("no_default_policy").(bool)
+
+	var paths []string
+	for _, v := range d.Get("paths").([]interface{}) {
+		paths = append(paths, strings.Trim(v.(string), "/"))
+	}
+
+	// Vault can only evaluate the capabilities of a token, so a short-lived
+	// token holding exactly the given policies is created for the lookup.
+	log.Printf("[DEBUG] Creating token with policies %v to look up capabilities", policies)
+	secret, err := client.Auth().Token().Create(&api.TokenCreateRequest{
+		Policies:        policies,
+		NoDefaultPolicy: noDefaultPolicy,
+		TTL:             "1m",
+		DisplayName:     "terraform-policy-capabilities",
+	})
+	if err != nil {
+		return fmt.Errorf("error creating token with policies %v: %s", policies, err)
+	}
+	token, err := secret.TokenID()
+	if err != nil {
+		return fmt.Errorf("error reading token with policies %v: %s", policies, err)
+	}
+	log.Printf("[DEBUG] Created token with policies %v to look up capabilities", policies)
+
+	defer func() {
+		log.Printf("[DEBUG] Revoking token with policies %v", policies)
+		if err := client.Auth().Token().RevokeTree(token); err != nil {
+			log.Printf("[WARN] Error revoking token with policies %v: %s", policies, err)
+			return
+		}
+		log.Printf("[DEBUG] Revoked token with policies %v", policies)
+	}()
+
+	var capabilities []map[string]interface{}
+	for _, path := range paths {
+		log.Printf("[DEBUG] Reading capabilities on %q", path)
+		caps, err := client.Sys().Capabilities(token, path)
+		if err != nil {
+			return fmt.Errorf("error reading capabilities on %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Read capabilities on %q", path)
+
+		sort.Strings(caps)
+		capabilities = append(capabilities, map[string]interface{}{
+			"path":         path,
+			"capabilities": caps,
+		})
+	}
+
+	id := fmt.Sprintf("%s:%t:%s", strings.Join(policies, ","), noDefaultPolicy, strings.Join(paths, ","))
+	d.SetId(strconv.Itoa(helper.HashCodeString(id)))
+
+	if err := d.Set("capabilities", capabilities); err != nil {
+		return fmt.Errorf("error setting capabilities: %s", err)
+	}
+
+	return nil
+}
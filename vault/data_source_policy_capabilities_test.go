@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourcePolicyCapabilities(t *testing.T) {
+	policy := acctest.RandomWithPrefix("tf-test-policy")
+	resourceName := "data.vault_policy_capabilities.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePolicyCapabilitiesConfig(policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.0.path", "secret/dev/app"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.0.capabilities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.0.capabilities.0", "list"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.0.capabilities.1", "read"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.1.path", "secret/dev/admin"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.1.capabilities.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.1.capabilities.0", "deny"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.2.path", "secret/prod/app"),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.2.capabilities.0", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePolicyCapabilitiesConfig(policy string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/dev/*" {
+  capabilities = ["read", "list"]
+}
+
+path "secret/dev/admin" {
+  capabilities = ["deny"]
+}
+EOT
+}
+
+data "vault_policy_capabilities" "test" {
+  policies          = [vault_policy.test.name]
+  no_default_policy = true
+  paths             = ["secret/dev/app", "/secret/dev/admin", "secret/prod/app"]
+}
+`, policy)
+}
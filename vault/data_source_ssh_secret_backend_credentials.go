@@ -0,0 +1,140 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSecretBackendCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ssh",
+				Description: "The path of the SSH Secret Backend to request credentials from.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the OTP role to request credentials for.",
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "IP of the remote host.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Username on the remote host. Defaults to the role's default_user.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The one-time password.",
+			},
+			"key_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the credentials, always 'otp'.",
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port of the remote host's SSH server.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := fmt.Sprintf("%s/creds/%s", backend, role)
+
+	data := map[string]interface{}{
+		"ip": d.Get("ip").(string),
+	}
+	if v, ok := d.GetOk("username"); ok {
+		data["username"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Requesting SSH credentials from %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error requesting SSH credentials from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Requested SSH credentials from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no role found at %q", path)
+	}
+
+	// CA roles issue certificates through the sign endpoint, only OTP roles
+	// hand out credentials here.
+	keyType, _ := secret.Data["key_type"].(string)
+	if keyType != "otp" {
+		return fmt.Errorf("role %q has key_type %q, only roles with key_type \"otp\" are supported", role, keyType)
+	}
+
+	key, _ := secret.Data["key"].(string)
+	if key == "" {
+		return fmt.Errorf("key is not set in response")
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("key", key)
+	d.Set("key_type", keyType)
+	d.Set("username", secret.Data["username"])
+	if port, ok := secret.Data["port"].(json.Number); ok {
+		if v, err := port.Int64(); err == nil {
+			d.Set("port", v)
+		}
+	}
+
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceSSHSecretBackendCredentials(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	dataName := "data.vault_ssh_secret_backend_credentials.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSSHSecretBackendCredentialsConfig(backend, "otp"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataName, "key"),
+					resource.TestCheckResourceAttrSet(dataName, "lease_id"),
+					resource.TestCheckResourceAttr(dataName, "key_type", "otp"),
+					resource.TestCheckResourceAttr(dataName, "username", "usr"),
+					resource.TestCheckResourceAttr(dataName, "port", "22"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSSHSecretBackendCredentials_caRole(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceSSHSecretBackendCredentialsConfig(backend, "ca"),
+				ExpectError: regexp.MustCompile(`error requesting SSH credentials|only roles with key_type "otp" are supported`),
+			},
+		},
+	})
+}
+
+func testDataSourceSSHSecretBackendCredentialsConfig(backend, keyType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  name                    = "test"
+  backend                 = vault_mount.example.path
+  default_user            = "usr"
+  key_type                = "%s"
+  cidr_list               = "10.0.0.0/8"
+  allow_user_certificates = true
+}
+
+data "vault_ssh_secret_backend_credentials" "test" {
+  backend = vault_mount.example.path
+  role    = vault_ssh_secret_backend_role.test.name
+  ip      = "10.0.0.10"
+}
+`, backend, keyType)
+}
@@ -0,0 +1,189 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func tokenDataSource() *schema.Resource {
+	fields := tokenLookupFields()
+	fields["accessor"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "Accessor of the token to look up. The provider's own token is looked up if unset.",
+	}
+
+	return &schema.Resource{
+		Read:   tokenDataSourceRead,
+		Schema: fields,
+	}
+}
+
+// tokenLookupFields returns the computed fields of a token lookup shared by
+// the token data sources.
+func tokenLookupFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"display_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The display name of the token.",
+		},
+		"entity_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ID of the identity entity the token is tied to.",
+		},
+		"policies": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Sorted union of token_policies and identity_policies.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"token_policies": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Sorted policies attached to the token itself.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"identity_policies": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Sorted policies the token is granted through its identity entity and groups.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"orphan": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the token has no parent.",
+		},
+		"renewable": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if the token can be renewed.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Remaining TTL of the token in seconds.",
+		},
+		"expire_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time the token expires, empty if it does not.",
+		},
+	}
+}
+
+func tokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var resp *api.Secret
+	var err error
+	accessor := d.Get("accessor").(string)
+	if accessor != "" {
+		log.Printf("[DEBUG] Reading token accessor %q", accessor)
+		resp, err = client.Auth().Token().LookupAccessor(accessor)
+		if err != nil {
+			return fmt.Errorf("error reading token accessor %q: %s", accessor, err)
+		}
+		log.Printf("[DEBUG] Read token accessor %q", accessor)
+	} else {
+		log.Printf("[DEBUG] Reading provider token")
+		resp, err = client.Auth().Token().LookupSelf()
+		if err != nil {
+			return fmt.Errorf("error reading provider token: %s", err)
+		}
+		log.Printf("[DEBUG] Read provider token")
+	}
+	if resp == nil {
+		return fmt.Errorf("no token found")
+	}
+
+	tokenAccessor, _ := resp.Data["accessor"].(string)
+	d.SetId(tokenAccessor)
+
+	return tokenLookupSet(d, resp)
+}
+
+// tokenLookupSet sets the fields returned by tokenLookupFields, as well as
+// the accessor, from a token lookup response.
+func tokenLookupSet(d *schema.ResourceData, resp *api.Secret) error {
+	tokenPolicies, err := tokenDataSourcePolicies(resp.Data["policies"])
+	if err != nil {
+		return fmt.Errorf("error reading policies: %s", err)
+	}
+	// Vault versions before identity was introduced do not report
+	// identity_policies, which leaves it empty.
+	identityPolicies, err := tokenDataSourcePolicies(resp.Data["identity_policies"])
+	if err != nil {
+		return fmt.Errorf("error reading identity_policies: %s", err)
+	}
+
+	seen := map[string]bool{}
+	policies := []string{}
+	for _, p := range append(append([]string{}, tokenPolicies...), identityPolicies...) {
+		if !seen[p] {
+			seen[p] = true
+			policies = append(policies, p)
+		}
+	}
+	sort.Strings(policies)
+
+	d.Set("accessor", resp.Data["accessor"])
+	d.Set("display_name", resp.Data["display_name"])
+	d.Set("entity_id", resp.Data["entity_id"])
+	d.Set("orphan", resp.Data["orphan"])
+	d.Set("renewable", resp.Data["renewable"])
+	d.Set("expire_time", resp.Data["expire_time"])
+
+	ttl, err := resp.TokenTTL()
+	if err != nil {
+		return fmt.Errorf("error reading ttl: %s", err)
+	}
+	d.Set("ttl", int(ttl.Seconds()))
+
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
+	if err := d.Set("token_policies", tokenPolicies); err != nil {
+		return fmt.Errorf("error setting token_policies: %s", err)
+	}
+	if err := d.Set("identity_policies", identityPolicies); err != nil {
+		return fmt.Errorf("error setting identity_policies: %s", err)
+	}
+
+	return nil
+}
+
+// tokenDataSourcePolicies returns the sorted policies of a token lookup field,
+// which is nil when Vault does not report it.
+func tokenDataSourcePolicies(v interface{}) ([]string, error) {
+	policies := []string{}
+	if v == nil {
+		return policies, nil
+	}
+	iPolicies, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", v)
+	}
+	for _, iPolicy := range iPolicies {
+		policy, ok := iPolicy.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected policy type %T", iPolicy)
+		}
+		policies = append(policies, policy)
+	}
+	sort.Strings(policies)
+	return policies, nil
+}
@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceToken(t *testing.T) {
+	policy := acctest.RandomWithPrefix("tf-test-policy")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTokenConfig(policy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.vault_token.test", "accessor",
+						"vault_token.test", "id"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "display_name", "token-test"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "token_policies.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "token_policies.0", "default"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "token_policies.1", policy),
+					resource.TestCheckResourceAttr("data.vault_token.test", "identity_policies.#", "0"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "policies.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_token.test", "renewable", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_token.test", "ttl"),
+					resource.TestCheckResourceAttrSet("data.vault_token.self", "accessor"),
+					resource.TestCheckResourceAttrSet("data.vault_token.self", "token_policies.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestTokenDataSourcePolicies(t *testing.T) {
+	tests := map[string]struct {
+		value   interface{}
+		want    []string
+		wantErr bool
+	}{
+		"missing": {
+			value: nil,
+			want:  []string{},
+		},
+		"sorted": {
+			value: []interface{}{"ops", "default", "dev"},
+			want:  []string{"default", "dev", "ops"},
+		},
+		"invalid": {
+			value:   "default",
+			wantErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tokenDataSourcePolicies(tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func testDataSourceTokenConfig(policy string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/*" {
+  capabilities = ["read"]
+}
+EOT
+}
+
+resource "vault_token" "test" {
+  display_name = "test"
+  policies     = [vault_policy.test.name]
+  renewable    = false
+  ttl          = "60s"
+}
+
+data "vault_token" "test" {
+  accessor = vault_token.test.id
+}
+
+data "vault_token" "self" {}
+`, policy)
+}
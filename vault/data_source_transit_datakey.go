@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// transitDatakeyTypes are the types of data keys Vault can generate. Only
+// plaintext data keys are returned unencrypted.
+var transitDatakeyTypes = []string{"plaintext", "wrapped"}
+
+func transitDatakeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitDatakeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to encrypt the data key with.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "wrapped",
+				Description:  "Type of the data key to generate, plaintext returns the data key unencrypted as well and stores it in the state, wrapped only returns its ciphertext.",
+				ValidateFunc: validation.StringInSlice(transitDatakeyTypes, false),
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the context for key derivation.",
+			},
+			"bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				Description:  "Number of bits of the data key, one of 128, 256 or 512.",
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512}),
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data key encrypted with the named key.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded data key, only set if key_type is plaintext.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the named key used to encrypt the data key.",
+			},
+		},
+	}
+}
+
+func transitDatakeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+	keyType := d.Get("key_type").(string)
+
+	path := backend + "/datakey/" + keyType + "/" + name
+	data := map[string]interface{}{
+		"bits": d.Get("bits").(int),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+
+	log.Printf("[DEBUG] Generating data key from %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating data key from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated data key from %q", path)
+	if resp == nil {
+		return fmt.Errorf("no data key generated from %q", path)
+	}
+
+	ciphertext, ok := resp.Data["ciphertext"].(string)
+	if !ok {
+		return fmt.Errorf("unexpected ciphertext in data key from %q: %T", path, resp.Data["ciphertext"])
+	}
+
+	d.SetId(path)
+	d.Set("ciphertext", ciphertext)
+	d.Set("key_version", resp.Data["key_version"])
+
+	// Vault only returns the plaintext for plaintext data keys.
+	plaintext, _ := resp.Data["plaintext"].(string)
+	d.Set("plaintext", plaintext)
+
+	return nil
+}
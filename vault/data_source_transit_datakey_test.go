@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceTransitDatakey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	dataSourceName := "data.vault_transit_datakey.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitDatakeyConfig(backend, "wrapped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", backend+"/datakey/wrapped/test"),
+					resource.TestMatchResourceAttr(dataSourceName, "ciphertext", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr(dataSourceName, "plaintext", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "key_version", "1"),
+				),
+			},
+			{
+				Config: testDataSourceTransitDatakeyConfig(backend, "plaintext"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", backend+"/datakey/plaintext/test"),
+					resource.TestMatchResourceAttr(dataSourceName, "ciphertext", regexp.MustCompile(`^vault:v1:`)),
+					// 128 bits, base64-encoded.
+					resource.TestMatchResourceAttr(dataSourceName, "plaintext", regexp.MustCompile(`^[A-Za-z0-9+/]{22}==$`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitDatakeyConfig(backend, keyType string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  deletion_allowed = true
+}
+
+data "vault_transit_datakey" "test" {
+  backend  = vault_mount.test.path
+  name     = vault_transit_secret_backend_key.test.name
+  key_type = "%s"
+  bits     = 128
+}
+`, backend, keyType)
+}
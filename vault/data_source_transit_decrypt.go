@@ -31,7 +31,7 @@ func transitDecryptDataSource() *schema.Resource {
 			"context": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Specifies the context for key derivation",
+				Description: "Specifies the context for key derivation, required for keys with derived set to true. It is base64-encoded before being sent to Vault.",
 			},
 			"ciphertext": {
 				Type:        schema.TypeString,
@@ -49,10 +49,12 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	key := d.Get("key").(string)
 	ciphertext := d.Get("ciphertext").(string)
 
-	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
+	context := d.Get("context").(string)
 	payload := map[string]interface{}{
 		"ciphertext": ciphertext,
-		"context":    context,
+	}
+	if context != "" {
+		payload["context"] = base64.StdEncoding.EncodeToString([]byte(context))
 	}
 
 	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
@@ -60,9 +62,13 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
 
+	if decryptedData == nil {
+		return fmt.Errorf("no plaintext returned by decrypting with key %q", key)
+	}
+
 	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
 
-	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
+	d.SetId(transitDataSourceID(ciphertext, context))
 	d.Set("plaintext", string(plaintext))
 
 	return nil
@@ -32,7 +32,7 @@ func transitEncryptDataSource() *schema.Resource {
 			"context": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Specifies the context for key derivation",
+				Description: "Specifies the context for key derivation, required for keys with derived set to true. It is base64-encoded before being sent to Vault.",
 			},
 			"key_version": {
 				Type:        schema.TypeInt,
@@ -56,22 +56,42 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	keyVersion := d.Get("key_version").(int)
 
 	plaintext := base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string)))
-	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
+	context := d.Get("context").(string)
 	payload := map[string]interface{}{
 		"plaintext":   plaintext,
-		"context":     context,
 		"key_version": keyVersion,
 	}
+	if context != "" {
+		payload["context"] = base64.StdEncoding.EncodeToString([]byte(context))
+	}
 
 	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
 
-	cipherText := encryptedData.Data["ciphertext"]
+	if encryptedData == nil {
+		return fmt.Errorf("no ciphertext returned by encrypting with key %q", key)
+	}
+
+	cipherText, ok := encryptedData.Data["ciphertext"].(string)
+	if !ok {
+		return fmt.Errorf("unexpected ciphertext returned by encrypting with key %q: %T", key, encryptedData.Data["ciphertext"])
+	}
 
-	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText.(string))))
+	d.SetId(transitDataSourceID(cipherText, context))
 	d.Set("ciphertext", cipherText)
 
 	return nil
 }
+
+// transitDataSourceID returns the ID of the transit encrypt and decrypt data
+// sources. The derivation context is part of the ID, so that the same
+// ciphertext used with another context is a different instance.
+func transitDataSourceID(ciphertext, context string) string {
+	id := ciphertext
+	if context != "" {
+		id += ":" + context
+	}
+	return base64.StdEncoding.EncodeToString([]byte(id))
+}
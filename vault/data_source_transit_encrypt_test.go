@@ -1,9 +1,12 @@
 package vault
 
 import (
+	"encoding/base64"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -65,3 +68,65 @@ func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDataSourceTransitEncrypt_derived(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitEncryptConfig_derived(backend, "context1", "context1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "context", "context1"),
+				),
+			},
+			{
+				Config:      testDataSourceTransitEncryptConfig_derived(backend, "context1", "context2"),
+				ExpectError: regexp.MustCompile(`message authentication failed`),
+			},
+		},
+	})
+}
+
+func TestTransitDataSourceID(t *testing.T) {
+	ciphertext := "vault:v1:abcd"
+	if transitDataSourceID(ciphertext, "") != base64.StdEncoding.EncodeToString([]byte(ciphertext)) {
+		t.Errorf("expected the ID without a context to only depend on the ciphertext")
+	}
+	if transitDataSourceID(ciphertext, "context1") == transitDataSourceID(ciphertext, "context2") {
+		t.Errorf("expected the ID to depend on the context")
+	}
+}
+
+func testDataSourceTransitEncryptConfig_derived(backend, encryptContext, decryptContext string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  derived          = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  plaintext = "foo"
+  context   = "%s"
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.test.path
+  key        = vault_transit_secret_backend_key.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+  context    = "%s"
+}
+`, backend, encryptContext, decryptContext)
+}
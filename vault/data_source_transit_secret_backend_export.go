@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// transitExportKeyTypes are the types of key material Vault can export.
+var transitExportKeyTypes = []string{"encryption-key", "signing-key", "hmac-key"}
+
+func transitSecretBackendExportDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitSecretBackendExportDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to export.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Type of the key material to export, one of encryption-key, signing-key or hmac-key.",
+				ValidateFunc: validation.StringInSlice(transitExportKeyTypes, false),
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Version of the key to export. All versions are exported if unset.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"keys": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Key material by version.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transitSecretBackendExportDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+	keyType := d.Get("type").(string)
+
+	keyPath := backend + "/keys/" + key
+	log.Printf("[DEBUG] Reading transit key %q", keyPath)
+	keyResp, err := client.Logical().Read(keyPath)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", keyPath, err)
+	}
+	log.Printf("[DEBUG] Read transit key %q", keyPath)
+	if keyResp == nil {
+		return fmt.Errorf("transit key %q not found", keyPath)
+	}
+	if exportable, _ := keyResp.Data["exportable"].(bool); !exportable {
+		return fmt.Errorf("transit key %q is not exportable, set exportable to true on the key to export it", keyPath)
+	}
+
+	path := backend + "/export/" + keyType + "/" + key
+	if v, ok := d.GetOk("version"); ok {
+		path += "/" + strconv.Itoa(v.(int))
+	}
+
+	log.Printf("[DEBUG] Exporting transit key from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error exporting transit key from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Exported transit key from %q", path)
+	if resp == nil {
+		return fmt.Errorf("no key material exported from %q", path)
+	}
+
+	keys, ok := resp.Data["keys"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected keys in export from %q: %T", path, resp.Data["keys"])
+	}
+
+	d.SetId(path)
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestDataSourceTransitSecretBackendExport(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitSecretBackendExportConfig(backend, false, ""),
+				ExpectError: regexp.MustCompile(`is not exportable`),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendExportConfig(backend, true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_secret_backend_export.test", "keys.%", "1"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_secret_backend_export.test", "keys.1"),
+				),
+			},
+			{
+				Config: testDataSourceTransitSecretBackendExportConfig(backend, true, "version = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_secret_backend_export.test", "id",
+						backend+"/export/encryption-key/test/1"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_secret_backend_export.test", "keys.1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSecretBackendExportConfig(backend string, exportable bool, version string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.test.path
+  name             = "test"
+  exportable       = %t
+  deletion_allowed = true
+}
+
+data "vault_transit_secret_backend_export" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  type    = "encryption-key"
+  %s
+}
+`, backend, exportable, version)
+}
@@ -5,12 +5,24 @@ import (
 	"io"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
 )
 
-func versionedSecret(requestedVersion int, path string, client *api.Client) (*api.Secret, error) {
-	mountPath, v2, err := isKVv2(path, client)
+// kvVersionAuto detects the KV version of a path from its mount.
+const kvVersionAuto = 0
+
+// kvMountCache holds the mounts whose KV version was detected, so that
+// reading or writing many secrets of the same mount only looks it up once.
+var kvMountCache = struct {
+	sync.RWMutex
+	versions map[string]int
+}{versions: map[string]int{}}
+
+func versionedSecret(requestedVersion int, path string, client *api.Client, kvVersion int) (*api.Secret, error) {
+	path, v2, err := kvAPIPath(client, path, "data", kvVersion)
 	if err != nil {
 		return nil, err
 	}
@@ -18,11 +30,6 @@ func versionedSecret(requestedVersion int, path string, client *api.Client) (*ap
 	var versionParam map[string]string
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
-		if err != nil {
-			return nil, err
-		}
-
 		if requestedVersion > 0 {
 			versionParam = map[string]string{
 				"version": fmt.Sprintf("%d", requestedVersion),
@@ -128,7 +135,7 @@ func kvPreflightVersionRequest(client *api.Client, path string) (string, int, er
 }
 
 func isKVv2(path string, client *api.Client) (string, bool, error) {
-	mountPath, version, err := kvPreflightVersionRequest(client, path)
+	mountPath, version, err := kvMountVersion(client, path)
 	if err != nil {
 		return "", false, err
 	}
@@ -136,6 +143,95 @@ func isKVv2(path string, client *api.Client) (string, bool, error) {
 	return mountPath, version == 2, nil
 }
 
+// mountCacheKeyPrefix scopes cached mount lookups to the Vault server and
+// namespace of client.
+func mountCacheKeyPrefix(client *api.Client) string {
+	return client.Address() + "|" + client.Headers().Get(consts.NamespaceHeaderName) + "|"
+}
+
+// kvMountVersion returns the mount of path and its KV version, using the
+// cached version of the mount if it was already detected.
+func kvMountVersion(client *api.Client, p string) (string, int, error) {
+	prefix := mountCacheKeyPrefix(client)
+
+	kvMountCache.RLock()
+	var mountPath string
+	version := 0
+	for k, v := range kvMountCache.versions {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		m := strings.TrimPrefix(k, prefix)
+		if strings.HasPrefix(strings.Trim(p, "/")+"/", m) && len(m) > len(mountPath) {
+			mountPath, version = m, v
+		}
+	}
+	kvMountCache.RUnlock()
+	if version != 0 {
+		return mountPath, version, nil
+	}
+
+	mountPath, version, err := kvPreflightVersionRequest(client, p)
+	if err != nil {
+		return "", 0, err
+	}
+
+	// Without a mount path the version was assumed, e.g. because the token
+	// may not read the mount, so it is looked up again next time.
+	if mountPath != "" {
+		kvMountCache.Lock()
+		kvMountCache.versions[prefix+mountPath] = version
+		kvMountCache.Unlock()
+	}
+
+	return mountPath, version, nil
+}
+
+// kvMountCacheInvalidate drops the cached KV version of the mount at
+// mountPath, which must be called whenever the provider changes a mount.
+func kvMountCacheInvalidate(client *api.Client, mountPath string) {
+	key := mountCacheKeyPrefix(client) + strings.Trim(mountPath, "/") + "/"
+
+	kvMountCache.Lock()
+	delete(kvMountCache.versions, key)
+	kvMountCache.Unlock()
+}
+
+// kvAPIPath returns the API path of the secret at p and whether it belongs
+// to a KV-V2 engine. With kvVersionAuto, the version is detected from the
+// mount, and for KV-V2 an apiPrefix segment given as part of p is stripped
+// before it is inserted, so that both "secret/foo" and "secret/data/foo"
+// address the same secret. With kvVersion 1 p is used as is, and with
+// kvVersion 2 apiPrefix is always inserted.
+func kvAPIPath(client *api.Client, p, apiPrefix string, kvVersion int) (string, bool, error) {
+	if kvVersion == 1 {
+		return p, false, nil
+	}
+
+	mountPath, version, err := kvMountVersion(client, p)
+	if err != nil {
+		return "", false, fmt.Errorf("error determining if it's a v2 path: %s", err)
+	}
+
+	if kvVersion == 2 {
+		if mountPath == "" {
+			return "", false, fmt.Errorf("unable to determine the mount of %q", p)
+		}
+		return addPrefixToVKVPath(p, mountPath, apiPrefix), true, nil
+	}
+
+	if version != 2 {
+		return p, false, nil
+	}
+
+	rel := strings.TrimPrefix(strings.TrimPrefix(p, mountPath), "/")
+	if strings.HasPrefix(rel, apiPrefix+"/") {
+		p = path.Join(mountPath, strings.TrimPrefix(rel, apiPrefix+"/"))
+	}
+
+	return addPrefixToVKVPath(p, mountPath, apiPrefix), true, nil
+}
+
 func addPrefixToVKVPath(p, mountPath, apiPrefix string) string {
 	switch {
 	case p == mountPath, p == strings.TrimSuffix(mountPath, "/"):
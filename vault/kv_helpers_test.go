@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+// testKVMountServer serves sys/internal/ui/mounts for a single mount of the
+// given KV version and counts the lookups.
+func testKVMountServer(t *testing.T, mountPath, version string, lookups *int) *api.Client {
+	t.Helper()
+
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.URL.Path, "/v1/sys/internal/ui/mounts/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		*lookups++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"data":{"path":%q,"type":"kv","options":{"version":%q}}}`, mountPath, version)
+	})
+	return client
+}
+
+func TestKVAPIPath(t *testing.T) {
+	tests := map[string]struct {
+		version   string
+		path      string
+		kvVersion int
+		want      string
+		wantV2    bool
+	}{
+		"v1": {
+			version: "1",
+			path:    "secret/foo",
+			want:    "secret/foo",
+		},
+		"v1 with data segment": {
+			version: "1",
+			path:    "secret/data/foo",
+			want:    "secret/data/foo",
+		},
+		"v2": {
+			version: "2",
+			path:    "secret/foo",
+			want:    "secret/data/foo",
+			wantV2:  true,
+		},
+		"v2 with data segment": {
+			version: "2",
+			path:    "secret/data/foo/bar",
+			want:    "secret/data/foo/bar",
+			wantV2:  true,
+		},
+		"v2 forced": {
+			version:   "2",
+			path:      "secret/data/foo",
+			kvVersion: 2,
+			want:      "secret/data/data/foo",
+			wantV2:    true,
+		},
+		"v1 forced": {
+			version:   "2",
+			path:      "secret/foo",
+			kvVersion: 1,
+			want:      "secret/foo",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lookups := 0
+			client := testKVMountServer(t, "secret/", tc.version, &lookups)
+
+			got, v2, err := kvAPIPath(client, tc.path, "data", tc.kvVersion)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tc.want {
+				t.Errorf("expected path %q, got %q", tc.want, got)
+			}
+			if v2 != tc.wantV2 {
+				t.Errorf("expected v2 %t, got %t", tc.wantV2, v2)
+			}
+		})
+	}
+}
+
+func TestKVMountVersion_cached(t *testing.T) {
+	lookups := 0
+	client := testKVMountServer(t, "secret/", "2", &lookups)
+
+	for _, p := range []string{"secret/foo", "secret/bar", "secret/baz/qux"} {
+		mountPath, version, err := kvMountVersion(client, p)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if mountPath != "secret/" || version != 2 {
+			t.Fatalf("expected mount secret/ of version 2, got %q of version %d", mountPath, version)
+		}
+	}
+	if lookups != 1 {
+		t.Fatalf("expected 1 lookup, got %d", lookups)
+	}
+
+	if _, _, err := kvMountVersion(client, "secret-other/foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lookups != 2 {
+		t.Fatalf("expected a lookup for another mount, got %d lookups", lookups)
+	}
+
+	kvMountCacheInvalidate(client, "secret")
+	if _, _, err := kvMountVersion(client, "secret/foo"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if lookups != 3 {
+		t.Fatalf("expected a lookup after invalidation, got %d lookups", lookups)
+	}
+}
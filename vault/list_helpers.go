@@ -0,0 +1,156 @@
+package vault
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// listPageSize is the number of keys requested per LIST request. Endpoints
+// without pagination support ignore it and return all of their keys at once.
+var listPageSize = 1000
+
+// listRetryTimeout is how long a single LIST request is retried while it
+// fails with a transient error.
+var listRetryTimeout = 30 * time.Second
+
+// listRetryHTTPCodes are the status codes of LIST responses that are retried,
+// e.g. when a proxy in front of Vault times out or a standby is not yet
+// consistent with the active node.
+var listRetryHTTPCodes = []int{
+	http.StatusPreconditionFailed,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// listKeys lists all keys under path, along with their key_info if the
+// endpoint returns any. Keys are requested in pages of listPageSize, and
+// requests failing with a transient error are retried. A path that does not
+// exist or has no keys returns no keys and no error.
+func listKeys(client *api.Client, path string) ([]string, map[string]interface{}, error) {
+	var keys []string
+	keyInfo := map[string]interface{}{}
+	seen := map[string]bool{}
+
+	after := ""
+	for {
+		secret, err := listPage(client, path, after)
+		if err != nil {
+			return nil, nil, err
+		}
+		if secret == nil {
+			break
+		}
+
+		page, pageInfo, err := listResponseKeys(path, secret)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		added := 0
+		for _, k := range page {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			keys = append(keys, k)
+			added++
+		}
+		for k, v := range pageInfo {
+			keyInfo[k] = v
+		}
+
+		// A short page is the last one. A page without new keys means the
+		// endpoint ignored the pagination parameters and already returned
+		// all of its keys.
+		if len(page) < listPageSize || added == 0 {
+			break
+		}
+		after = page[len(page)-1]
+	}
+
+	return keys, keyInfo, nil
+}
+
+// listPage lists the keys under path following after, retrying transient
+// errors.
+func listPage(client *api.Client, path, after string) (*api.Secret, error) {
+	var secret *api.Secret
+	err := resource.Retry(listRetryTimeout, func() *resource.RetryError {
+		var err error
+		secret, err = listPageRequest(client, path, after)
+		if err != nil {
+			if util.ErrorContainsHTTPCode(err, listRetryHTTPCodes...) || err == io.ErrUnexpectedEOF {
+				log.Printf("[DEBUG] Retrying LIST of %q: %s", path, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing %q: %s", path, err)
+	}
+	return secret, nil
+}
+
+func listPageRequest(client *api.Client, path, after string) (*api.Secret, error) {
+	r := client.NewRequest("GET", "/v1/"+strings.Trim(path, "/"))
+	r.Params.Set("list", "true")
+	r.Params.Set("limit", strconv.Itoa(listPageSize))
+	if after != "" {
+		r.Params.Set("after", after)
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := api.ParseSecret(resp.Body)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return secret, err
+}
+
+// listResponseKeys returns the keys and key_info of a LIST response.
+func listResponseKeys(path string, secret *api.Secret) ([]string, map[string]interface{}, error) {
+	if secret.Data == nil || secret.Data["keys"] == nil {
+		return nil, nil, nil
+	}
+
+	rawKeys, ok := secret.Data["keys"].([]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected keys listed under %q: %#v", path, secret.Data["keys"])
+	}
+
+	keys := make([]string, 0, len(rawKeys))
+	for _, rawKey := range rawKeys {
+		key, ok := rawKey.(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("unexpected key listed under %q: %#v", path, rawKey)
+		}
+		keys = append(keys, key)
+	}
+
+	keyInfo, _ := secret.Data["key_info"].(map[string]interface{})
+
+	return keys, keyInfo, nil
+}
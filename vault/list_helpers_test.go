@@ -0,0 +1,162 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+func testListWriteKeys(t *testing.T, w http.ResponseWriter, keys []string) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{
+		"data": map[string]interface{}{"keys": keys},
+	}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListKeys_large(t *testing.T) {
+	allKeys := make([]string, 2500)
+	for i := range allKeys {
+		allKeys[i] = fmt.Sprintf("key-%05d", i)
+	}
+
+	t.Run("paginated", func(t *testing.T) {
+		requests := 0
+		client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+			if err != nil {
+				t.Errorf("invalid limit: %s", err)
+			}
+			after := r.URL.Query().Get("after")
+			start := sort.SearchStrings(allKeys, after)
+			if start < len(allKeys) && allKeys[start] == after {
+				start++
+			}
+			end := start + limit
+			if end > len(allKeys) {
+				end = len(allKeys)
+			}
+			testListWriteKeys(t, w, allKeys[start:end])
+		})
+
+		keys, _, err := listKeys(client, "secret/metadata/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != len(allKeys) || keys[0] != allKeys[0] || keys[len(keys)-1] != allKeys[len(allKeys)-1] {
+			t.Fatalf("expected %d keys, got %d", len(allKeys), len(keys))
+		}
+		if requests != 3 {
+			t.Fatalf("expected 3 pages, got %d requests", requests)
+		}
+	})
+
+	t.Run("pagination unsupported", func(t *testing.T) {
+		requests := 0
+		client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			testListWriteKeys(t, w, allKeys)
+		})
+
+		keys, _, err := listKeys(client, "secret/metadata/")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(keys) != len(allKeys) {
+			t.Fatalf("expected %d keys, got %d", len(allKeys), len(keys))
+		}
+		if requests != 2 {
+			t.Fatalf("expected 2 requests, got %d", requests)
+		}
+	})
+}
+
+func TestListKeys(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		body         string
+		expectedKeys []string
+		keyInfoKey   string
+		expectErr    bool
+	}{
+		{
+			name:         "keys and key_info",
+			statuses:     []int{http.StatusOK},
+			body:         `{"data":{"keys":["a","b/"],"key_info":{"a":{"name":"alice"}}}}`,
+			expectedKeys: []string{"a", "b/"},
+			keyInfoKey:   "a",
+		},
+		{
+			name:     "not found",
+			statuses: []int{http.StatusNotFound},
+			body:     `{"errors":[]}`,
+		},
+		{
+			name:     "no keys",
+			statuses: []int{http.StatusOK},
+			body:     `{"data":{}}`,
+		},
+		{
+			name:         "retry transient errors",
+			statuses:     []int{http.StatusBadGateway, http.StatusOK},
+			body:         `{"data":{"keys":["a"]}}`,
+			expectedKeys: []string{"a"},
+		},
+		{
+			name:      "permission denied",
+			statuses:  []int{http.StatusForbidden},
+			body:      `{"errors":["permission denied"]}`,
+			expectErr: true,
+		},
+		{
+			name:      "non-string key",
+			statuses:  []int{http.StatusOK},
+			body:      `{"data":{"keys":["a",1]}}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				status := tt.statuses[len(tt.statuses)-1]
+				if requests < len(tt.statuses) {
+					status = tt.statuses[requests]
+				}
+				requests++
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				if status == http.StatusOK || status == http.StatusNotFound || status == http.StatusForbidden {
+					fmt.Fprint(w, tt.body)
+				}
+			})
+
+			keys, keyInfo, err := listKeys(client, "identity/entity-alias/id")
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if fmt.Sprint(keys) != fmt.Sprint(tt.expectedKeys) {
+				t.Fatalf("expected keys %v, got %v", tt.expectedKeys, keys)
+			}
+			if tt.keyInfoKey != "" && keyInfo[tt.keyInfoKey] == nil {
+				t.Fatalf("expected key_info for %q, got %v", tt.keyInfoKey, keyInfo)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func mfaLegacyPath(methodType, name string) string {
+	return "sys/mfa/method/" + methodType + "/" + strings.Trim(name, "/") + "/"
+}
+
+// mfaLegacyMappingSchema adds the fields used by the step-up MFA methods that
+// map the aliases of an auth mount to MFA usernames.
+func mfaLegacyMappingSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["mount_accessor"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The mount to tie this method to for use in automatic mappings. The mapping will use the Name field of Aliases associated with this mount as the username in the mapping.",
+	}
+	s["username_format"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "A format string for mapping Identity names to MFA method names. Values to substitute should be placed in `{{}}`.",
+	}
+	return s
+}
+
+// mfaLegacyWriteError wraps an error writing an MFA method. If the endpoint
+// is missing because the server is not Vault Enterprise, which is the only
+// edition serving the step-up MFA methods under sys/mfa, the error says so.
+func mfaLegacyWriteError(client *api.Client, err error) error {
+	if !util.Is404(err) && !strings.Contains(err.Error(), "unsupported path") {
+		return fmt.Errorf("error writing to Vault: %s", err)
+	}
+
+	status, statusErr := client.Sys().SealStatus()
+	if statusErr != nil {
+		log.Printf("[WARN] Unable to read the Vault version: %s", statusErr)
+		return fmt.Errorf("error writing to Vault: %s", err)
+	}
+
+	if !mfaLegacyUnsupported(status.Version) {
+		return fmt.Errorf("error writing to Vault: %s", err)
+	}
+
+	return fmt.Errorf("MFA methods under sys/mfa/method are only available in Vault Enterprise "+
+		"and the server runs %s: %s", status.Version, err)
+}
+
+// mfaLegacyUnsupported reports whether the Vault version is not an
+// Enterprise version, and so does not serve the MFA methods under sys/mfa.
+// Only Enterprise builds carry build metadata, such as +ent, +prem, +pro or
+// +ent.hsm. Versions that cannot be parsed are assumed to support them,
+// leaving the original error to speak for itself.
+func mfaLegacyUnsupported(version string) bool {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 3 {
+		return false
+	}
+	for _, part := range parts[:2] {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+
+	return !strings.Contains(parts[2], "+")
+}
+
+// mfaLegacyRead reads the MFA method at path, removing the resource
+// from state if it no longer exists.
+func mfaLegacyRead(d *schema.ResourceData, client *api.Client, path string) (*api.Secret, error) {
+	log.Printf("[DEBUG] Reading MFA method %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Read MFA method %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] MFA method %q not found, removing from state", path)
+		d.SetId("")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestMFALegacyUnsupported(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"1.7.3+ent", false},
+		{"1.12.2+ent.hsm", false},
+		{"1.12.0+prem", false},
+		{"1.12.0+pro", false},
+		{"1.12.0+prem.hsm.fips1402", false},
+		{"1.10.0", true},
+		{"v1.11.1", true},
+		{"1.13.0-rc1", true},
+		{"", false},
+		{"unknown", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := mfaLegacyUnsupported(tt.version); got != tt.want {
+				t.Errorf("mfaLegacyUnsupported(%q) = %v, want %v", tt.version, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMFALegacyWriteError(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		status   int
+		body     string
+		expected *regexp.Regexp
+	}{
+		{
+			name:     "open source",
+			version:  "1.12.0",
+			status:   http.StatusNotFound,
+			body:     `{"errors":["1 error occurred:\n\t* unsupported path\n\n"]}`,
+			expected: regexp.MustCompile(`^MFA methods under sys/mfa/method are only available in Vault Enterprise and the server runs 1\.12\.0: `),
+		},
+		{
+			name:     "enterprise",
+			version:  "1.12.0+ent",
+			status:   http.StatusNotFound,
+			body:     `{"errors":["1 error occurred:\n\t* unsupported path\n\n"]}`,
+			expected: regexp.MustCompile(`^error writing to Vault: `),
+		},
+		{
+			name:     "other error",
+			version:  "1.12.0+ent",
+			status:   http.StatusBadRequest,
+			body:     `{"errors":["missing issuer"]}`,
+			expected: regexp.MustCompile(`^error writing to Vault: `),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/v1/sys/seal-status" {
+					fmt.Fprintf(w, `{"sealed":false,"version":%q}`, tt.version)
+					return
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			})
+
+			_, err := client.Logical().Write(mfaLegacyPath("totp", "test"), map[string]interface{}{})
+			if err == nil {
+				t.Fatal("expected the write to fail")
+			}
+
+			err = mfaLegacyWriteError(client, err)
+			if !tt.expected.MatchString(err.Error()) {
+				t.Fatalf("expected error to match %q, got %q", tt.expected, err)
+			}
+		})
+	}
+}
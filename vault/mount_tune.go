@@ -0,0 +1,35 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// mountListingVisibilityValues are the values Vault accepts for the
+// listing_visibility of both secret and auth mounts.
+var mountListingVisibilityValues = []string{"unauth", "hidden"}
+
+// mountListingVisibilitySchema returns the schema of a listing_visibility
+// field. Vault treats an unset visibility as "hidden", so the two are
+// considered equal.
+func mountListingVisibilitySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  "Specifies whether to show this mount in the UI-specific listing endpoint. Valid values are \"unauth\" or \"hidden\". If not set, behaves like \"hidden\".",
+		ValidateFunc: validation.StringInSlice(mountListingVisibilityValues, false),
+		DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+			return mountListingVisibilityInput(old) == mountListingVisibilityInput(new)
+		},
+	}
+}
+
+// mountListingVisibilityInput returns the listing_visibility to tune a mount
+// with. The Vault client omits an empty value, which would leave the current
+// visibility in place, so an unset visibility is sent as "hidden".
+func mountListingVisibilityInput(v string) string {
+	if v == "" {
+		return "hidden"
+	}
+	return v
+}
@@ -29,20 +29,16 @@ func isOktaUserPresent(client *api.Client, path, username string) (bool, error)
 }
 
 func listOktaUsers(client *api.Client, path string) ([]string, error) {
-	secret, err := client.Logical().List(oktaUserEndpoint(path, ""))
+	keys, _, err := listKeys(client, oktaUserEndpoint(path, ""))
 	if err != nil {
 		return []string{}, err
 	}
 
-	if secret == nil || secret.Data == nil {
+	if keys == nil {
 		return []string{}, nil
 	}
 
-	if v, ok := secret.Data["keys"]; ok {
-		return util.ToStringArray(v.([]interface{})), nil
-	}
-
-	return []string{}, nil
+	return keys, nil
 }
 
 func readOktaUser(client *api.Client, path string, username string) (*oktaUser, error) {
@@ -101,20 +97,16 @@ func isOktaGroupPresent(client *api.Client, path, name string) (bool, error) {
 }
 
 func listOktaGroups(client *api.Client, path string) ([]string, error) {
-	secret, err := client.Logical().List(oktaGroupEndpoint(path, ""))
+	keys, _, err := listKeys(client, oktaGroupEndpoint(path, ""))
 	if err != nil {
 		return []string{}, err
 	}
 
-	if secret == nil || secret.Data == nil {
+	if keys == nil {
 		return []string{}, nil
 	}
 
-	if v, ok := secret.Data["keys"]; ok {
-		return util.ToStringArray(v.([]interface{})), nil
-	}
-
-	return []string{}, nil
+	return keys, nil
 }
 
 func readOktaGroup(client *api.Client, path string, name string) (*oktaGroup, error) {
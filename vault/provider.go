@@ -36,11 +36,11 @@ const (
 var vaultMutexKV = helper.NewMutexKV()
 
 func Provider() *schema.Provider {
-	dataSourcesMap, err := parse(DataSourceRegistry)
+	dataSourcesMap, err := parse(DataSourceRegistry, true)
 	if err != nil {
 		panic(err)
 	}
-	resourcesMap, err := parse(ResourceRegistry)
+	resourcesMap, err := parse(ResourceRegistry, false)
 	if err != nil {
 		panic(err)
 	}
@@ -165,14 +165,14 @@ func Provider() *schema.Provider {
 			"headers": {
 				Type:        schema.TypeList,
 				Optional:    true,
-				Sensitive:   true,
 				Description: "The headers to send with each Vault request.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
-							Type:        schema.TypeString,
-							Required:    true,
-							Description: "The header name",
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The header name",
+							ValidateFunc: validateHeaderName,
 						},
 						"value": {
 							Type:        schema.TypeString,
@@ -224,6 +224,19 @@ var (
 			Resource:      identityGroupDataSource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_oidc_introspect": {
+			Resource:      identityOidcIntrospectDataSource(),
+			PathInventory: []string{"/identity/oidc/introspect"},
+		},
+		"vault_key_status": {
+			Resource:      keyStatusDataSource(),
+			PathInventory: []string{"/sys/key-status"},
+		},
+		"vault_license": {
+			Resource:       licenseDataSource(),
+			PathInventory:  []string{"/sys/license/status"},
+			EnterpriseOnly: true,
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigDataSource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -236,6 +249,18 @@ var (
 			Resource:      adAccessCredentialsDataSource(),
 			PathInventory: []string{"/ad/creds/{role}"},
 		},
+		"vault_consul_secret_backend_creds": {
+			Resource:      consulSecretBackendCredsDataSource(),
+			PathInventory: []string{"/consul/creds/{role}"},
+		},
+		"vault_database_secret_backend_creds": {
+			Resource:      databaseSecretBackendCredsDataSource(),
+			PathInventory: []string{"/database/creds/{name}"},
+		},
+		"vault_database_secret_backend_static_creds": {
+			Resource:      databaseSecretBackendStaticCredsDataSource(),
+			PathInventory: []string{"/database/static-creds/{name}"},
+		},
 		"vault_nomad_access_token": {
 			Resource:      nomadAccessCredentialsDataSource(),
 			PathInventory: []string{"/nomad/creds/{role}"},
@@ -252,14 +277,48 @@ var (
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_mount_config": {
+			Resource:      mountConfigDataSource(),
+			PathInventory: []string{GenericPath},
+		},
+		"vault_mounts": {
+			Resource:      mountsDataSource(),
+			PathInventory: []string{"/sys/mounts"},
+		},
+		"vault_pki_secret_backend_cert": {
+			Resource:      pkiSecretBackendCertDataSource(),
+			PathInventory: []string{"/pki/issue/{role}"},
+		},
+		"vault_policy_capabilities": {
+			Resource: policyCapabilitiesDataSource(),
+			PathInventory: []string{
+				"/auth/token/create",
+				"/sys/capabilities",
+			},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
+		"vault_ssh_secret_backend_credentials": {
+			Resource:      sshSecretBackendCredentialsDataSource(),
+			PathInventory: []string{"/ssh/creds/{role}"},
+		},
 		"vault_auth_backend": {
 			Resource:      authBackendDataSource(),
 			PathInventory: []string{"/sys/auth"},
 		},
+		"vault_client_token": {
+			Resource:      clientTokenDataSource(),
+			PathInventory: []string{"/auth/token/lookup-self"},
+		},
+		"vault_token": {
+			Resource: tokenDataSource(),
+			PathInventory: []string{
+				"/auth/token/lookup-accessor",
+				"/auth/token/lookup-self",
+			},
+		},
 		"vault_transit_encrypt": {
 			Resource:      transitEncryptDataSource(),
 			PathInventory: []string{"/transit/encrypt/{name}"},
@@ -268,10 +327,37 @@ var (
 			Resource:      transitDecryptDataSource(),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_datakey": {
+			Resource:      transitDatakeyDataSource(),
+			PathInventory: []string{"/transit/datakey/{plaintext}/{name}"},
+		},
+		"vault_transit_secret_backend_export": {
+			Resource: transitSecretBackendExportDataSource(),
+			PathInventory: []string{
+				"/transit/export/{type}/{name}",
+				"/transit/export/{type}/{name}/{version}",
+			},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      gcpAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_gcp_secret_backend_roleset_token": {
+			Resource:      gcpSecretBackendRolesetTokenDataSource(),
+			PathInventory: []string{"/gcp/token/{roleset}"},
+		},
+		"vault_gcp_secret_backend_roleset_key": {
+			Resource:      gcpSecretBackendRolesetKeyDataSource(),
+			PathInventory: []string{"/gcp/key/{roleset}"},
+		},
+		"vault_gcp_secret_backend_static_account_token": {
+			Resource:      gcpSecretBackendStaticAccountTokenDataSource(),
+			PathInventory: []string{"/gcp/static-account/{name}/token"},
+		},
+		"vault_gcp_secret_backend_static_account_key": {
+			Resource:      gcpSecretBackendStaticAccountKeyDataSource(),
+			PathInventory: []string{"/gcp/static-account/{name}/key"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
@@ -351,10 +437,21 @@ var (
 			Resource:      awsAuthBackendRoleTagBlacklistResource(),
 			PathInventory: []string{"/auth/aws/config/tidy/roletag-blacklist"},
 		},
+		"vault_aws_auth_backend_roletag_denylist": {
+			Resource:      awsAuthBackendRoleTagDenylistResource(),
+			PathInventory: []string{"/auth/aws/roletag-denylist/{role_tag}"},
+		},
 		"vault_aws_auth_backend_sts_role": {
 			Resource:      awsAuthBackendSTSRoleResource(),
 			PathInventory: []string{"/auth/aws/config/sts/{account_id}"},
 		},
+		"vault_aws_auth_backend_tidy": {
+			Resource: awsAuthBackendTidyResource(),
+			PathInventory: []string{
+				"/auth/aws/tidy/identity-accesslist",
+				"/auth/aws/tidy/roletag-denylist",
+			},
+		},
 		"vault_aws_secret_backend": {
 			Resource:      awsSecretBackendResource(),
 			PathInventory: []string{"/aws/config/root"},
@@ -443,6 +540,10 @@ var (
 			Resource:      genericSecretResource(),
 			PathInventory: []string{GenericPath},
 		},
+		"vault_generic_secrets": {
+			Resource:      genericSecretsResource(),
+			PathInventory: []string{GenericPath},
+		},
 		"vault_jwt_auth_backend": {
 			Resource:      jwtAuthBackendResource(),
 			PathInventory: []string{"/auth/jwt/config"},
@@ -451,6 +552,10 @@ var (
 			Resource:      jwtAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/jwt/role/{name}"},
 		},
+		"vault_key_rotation_config": {
+			Resource:      keyRotationConfigResource(),
+			PathInventory: []string{"/sys/rotate/config"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -459,6 +564,17 @@ var (
 			Resource:      kubernetesAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kv_secret_backend_v2": {
+			Resource:      kvSecretBackendV2Resource(),
+			PathInventory: []string{"/secret/config"},
+		},
+		"vault_kv_secret_v2": {
+			Resource: kvSecretV2Resource(),
+			PathInventory: []string{
+				"/secret/data/{path}",
+				"/secret/metadata/{path}",
+			},
+		},
 		"vault_okta_auth_backend": {
 			Resource:      oktaAuthBackendResource(),
 			PathInventory: []string{"/auth/okta/config"},
@@ -514,6 +630,21 @@ var (
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_mfa_okta": {
+			Resource:       mfaOktaResource(),
+			PathInventory:  []string{"/sys/mfa/method/okta/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_mfa_pingid": {
+			Resource:       mfaPingIDResource(),
+			PathInventory:  []string{"/sys/mfa/method/pingid/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_mfa_totp": {
+			Resource:       mfaTOTPResource(),
+			PathInventory:  []string{"/sys/mfa/method/totp/{name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_mount": {
 			Resource:      MountResource(),
 			PathInventory: []string{"/sys/mounts/{path}"},
@@ -523,6 +654,14 @@ var (
 			PathInventory:  []string{"/sys/namespaces/{path}"},
 			EnterpriseOnly: true,
 		},
+		"vault_namespace_api_lock": {
+			Resource: namespaceAPILockResource(),
+			PathInventory: []string{
+				"/sys/namespaces/api-lock/lock/{path}",
+				"/sys/namespaces/api-lock/unlock/{path}",
+			},
+			EnterpriseOnly: true,
+		},
 		"vault_audit": {
 			Resource:      auditResource(),
 			PathInventory: []string{"/sys/audit/{path}"},
@@ -531,10 +670,18 @@ var (
 			Resource:      sshSecretBackendCAResource(),
 			PathInventory: []string{"/ssh/config/ca"},
 		},
+		"vault_ssh_secret_backend_config_zeroaddress": {
+			Resource:      sshSecretBackendConfigZeroAddressResource(),
+			PathInventory: []string{"/ssh/config/zeroaddress"},
+		},
 		"vault_ssh_secret_backend_role": {
 			Resource:      sshSecretBackendRoleResource(),
 			PathInventory: []string{"/ssh/roles/{role}"},
 		},
+		"vault_ssh_secret_backend_sign": {
+			Resource:      sshSecretBackendSignResource(),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
 		"vault_identity_entity": {
 			Resource:      identityEntityResource(),
 			PathInventory: []string{"/identity/entity"},
@@ -567,9 +714,16 @@ var (
 			Resource:      identityOidc(),
 			PathInventory: []string{"/identity/oidc/config"},
 		},
+		"vault_identity_oidc_assignment": {
+			Resource:      identityOidcAssignment(),
+			PathInventory: []string{"/identity/oidc/assignment/{name}"},
+		},
 		"vault_identity_oidc_key": {
-			Resource:      identityOidcKey(),
-			PathInventory: []string{"/identity/oidc/key/{name}"},
+			Resource: identityOidcKey(),
+			PathInventory: []string{
+				"/identity/oidc/key/{name}",
+				"/identity/oidc/key/{name}/rotate",
+			},
 		},
 		"vault_identity_oidc_key_allowed_client_id": {
 			Resource:      identityOidcKeyAllowedClientId(),
@@ -610,6 +764,14 @@ var (
 			Resource:      pkiSecretBackendConfigCAResource(),
 			PathInventory: []string{"/pki/config/ca"},
 		},
+		"vault_pki_secret_backend_config_acme": {
+			Resource:      pkiSecretBackendConfigAcmeResource(),
+			PathInventory: []string{"/pki/config/acme"},
+		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      pkiSecretBackendConfigClusterResource(),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
 		"vault_pki_secret_backend_config_urls": {
 			Resource:      pkiSecretBackendConfigUrlsResource(),
 			PathInventory: []string{"/pki/config/urls"},
@@ -864,11 +1026,11 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	return client, nil
 }
 
-func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
+func parse(descs map[string]*Description, dataSources bool) (map[string]*schema.Resource, error) {
 	var errs error
 	resourceMap := make(map[string]*schema.Resource)
 	for k, desc := range descs {
-		resourceMap[k] = desc.Resource
+		resourceMap[k] = WithNamespace(desc.Resource, dataSources)
 		if len(desc.PathInventory) == 0 {
 			errs = multierror.Append(errs, fmt.Errorf("%q needs its paths inventoried", k))
 		}
@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+// namespaceImportSeparator separates the namespace from the ID in the import
+// ID of a resource in a namespace, e.g. "team::my-policy", since import has no
+// access to the configuration. It is a double colon because some IDs contain
+// single colons, e.g. those of role tags.
+const namespaceImportSeparator = "::"
+
+// WithNamespace returns a copy of r that has a namespace argument and whose
+// functions are called with a client scoped to that namespace. The namespace
+// is relative to the namespace of the provider. Resources are replaced when
+// their namespace changes, since they live at a different path in Vault.
+func WithNamespace(r *schema.Resource, dataSource bool) *schema.Resource {
+	wrapped := *r
+
+	wrapped.Schema = make(map[string]*schema.Schema, len(r.Schema)+1)
+	for k, v := range r.Schema {
+		wrapped.Schema[k] = v
+	}
+	wrapped.Schema["namespace"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     !dataSource,
+		Description:  "Target namespace, relative to the namespace of the provider. Available only for Vault Enterprise.",
+		ValidateFunc: validateNoTrailingSlash,
+	}
+
+	wrapped.Create = namespaceCRUD(r.Create)
+	wrapped.Read = namespaceCRUD(r.Read)
+	wrapped.Update = namespaceCRUD(r.Update)
+	wrapped.Delete = namespaceCRUD(r.Delete)
+	wrapped.CreateContext = namespaceContextCRUD(r.CreateContext)
+	wrapped.ReadContext = namespaceContextCRUD(r.ReadContext)
+	wrapped.UpdateContext = namespaceContextCRUD(r.UpdateContext)
+	wrapped.DeleteContext = namespaceContextCRUD(r.DeleteContext)
+
+	if r.Exists != nil {
+		exists := r.Exists
+		wrapped.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, err := namespaceClient(meta, d.Get("namespace").(string))
+			if err != nil {
+				return false, err
+			}
+			return exists(d, client)
+		}
+	}
+
+	if r.CustomizeDiff != nil {
+		customizeDiff := r.CustomizeDiff
+		wrapped.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			client, err := namespaceClient(meta, d.Get("namespace").(string))
+			if err != nil {
+				return err
+			}
+			return customizeDiff(ctx, d, client)
+		}
+	}
+
+	if r.Importer != nil {
+		importer := *r.Importer
+		if importer.State != nil {
+			state := importer.State
+			importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client, err := namespaceImportClient(d, meta)
+				if err != nil {
+					return nil, err
+				}
+				return state(d, client)
+			}
+		}
+		if importer.StateContext != nil {
+			stateContext := importer.StateContext
+			importer.StateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				client, err := namespaceImportClient(d, meta)
+				if err != nil {
+					return nil, err
+				}
+				return stateContext(ctx, d, client)
+			}
+		}
+		wrapped.Importer = &importer
+	}
+
+	return &wrapped
+}
+
+func namespaceCRUD(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if f == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client, err := namespaceClient(meta, d.Get("namespace").(string))
+		if err != nil {
+			return err
+		}
+		return f(d, client)
+	}
+}
+
+func namespaceContextCRUD(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, err := namespaceClient(meta, d.Get("namespace").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		return f(ctx, d, client)
+	}
+}
+
+// namespaceImportClient splits the namespace off an import ID of the form
+// <namespace>::<id>, and returns a client scoped to it. The importer of the
+// resource then only sees <id>.
+func namespaceImportClient(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	if i := strings.Index(d.Id(), namespaceImportSeparator); i >= 0 {
+		if err := d.Set("namespace", strings.Trim(d.Id()[:i], "/")); err != nil {
+			return nil, err
+		}
+		d.SetId(d.Id()[i+len(namespaceImportSeparator):])
+	}
+	return namespaceClient(meta, d.Get("namespace").(string))
+}
+
+// namespaceClient returns a copy of the provider's client scoped to namespace,
+// nested under the provider's own namespace. The provider's client is
+// returned as is when namespace is empty.
+func namespaceClient(meta interface{}, namespace string) (interface{}, error) {
+	namespace = strings.Trim(namespace, "/")
+	if namespace == "" {
+		return meta, nil
+	}
+
+	client := meta.(*api.Client)
+	clone, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error configuring the Vault client for namespace %q: %s", namespace, err)
+	}
+	clone.SetHeaders(client.Headers())
+	clone.SetToken(client.Token())
+	clone.SetNamespace(path.Join(client.Headers().Get(consts.NamespaceHeaderName), namespace))
+
+	return clone, nil
+}
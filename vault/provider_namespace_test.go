@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
+)
+
+func TestProviderNamespaceSchema(t *testing.T) {
+	p := Provider()
+
+	for name, r := range p.ResourcesMap {
+		s, ok := r.Schema["namespace"]
+		if !ok {
+			t.Errorf("resource %q has no namespace argument", name)
+			continue
+		}
+		if !s.ForceNew {
+			t.Errorf("namespace argument of resource %q must force a new resource", name)
+		}
+	}
+	for name, r := range p.DataSourcesMap {
+		if _, ok := r.Schema["namespace"]; !ok {
+			t.Errorf("data source %q has no namespace argument", name)
+		}
+	}
+
+	// The registries are shared by every provider instance and must be left
+	// untouched.
+	for name, desc := range ResourceRegistry {
+		if _, ok := desc.Resource.Schema["namespace"]; ok {
+			t.Errorf("registered resource %q was modified", name)
+		}
+	}
+}
+
+func TestNamespaceClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		parent    string
+		namespace string
+		expected  string
+	}{
+		{"provider only", "parent", "", "parent"},
+		{"resource only", "", "child", "child"},
+		{"nested", "parent", "child", "parent/child"},
+		{"slashes", "parent", "/child/grandchild/", "parent/child/grandchild"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var namespace, token string
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				namespace = r.Header.Get(consts.NamespaceHeaderName)
+				token = r.Header.Get(consts.AuthHeaderName)
+				w.WriteHeader(http.StatusNoContent)
+			})
+			if tt.parent != "" {
+				client.SetNamespace(tt.parent)
+			}
+
+			meta, err := namespaceClient(client, tt.namespace)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := meta.(*api.Client).Logical().Write("sys/test", nil); err != nil {
+				t.Fatal(err)
+			}
+
+			if namespace != tt.expected {
+				t.Errorf("expected namespace %q, got %q", tt.expected, namespace)
+			}
+			if token != "test" {
+				t.Errorf("expected token %q, got %q", "test", token)
+			}
+			if got := client.Headers().Get(consts.NamespaceHeaderName); got != tt.parent {
+				t.Errorf("provider client namespace changed to %q", got)
+			}
+		})
+	}
+}
+
+func TestNamespaceImportClient(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		expectID  string
+		namespace string
+	}{
+		{"plain", "my-policy", "my-policy", ""},
+		{"namespaced", "team/::my-policy", "my-policy", "team"},
+		{"nested", "parent/child::auth/approle/role/test", "auth/approle/role/test", "parent/child"},
+		{"single colons", "auth/aws/roletag-denylist/v1:abc:r=dev", "auth/aws/roletag-denylist/v1:abc:r=dev", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := api.NewClient(api.DefaultConfig())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			d := WithNamespace(policyResource(), false).TestResourceData()
+			d.SetId(tt.id)
+
+			meta, err := namespaceImportClient(d, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if d.Id() != tt.expectID {
+				t.Errorf("expected ID %q, got %q", tt.expectID, d.Id())
+			}
+			if got := d.Get("namespace").(string); got != tt.namespace {
+				t.Errorf("expected namespace %q, got %q", tt.namespace, got)
+			}
+			if got := meta.(*api.Client).Headers().Get(consts.NamespaceHeaderName); got != tt.namespace {
+				t.Errorf("expected client namespace %q, got %q", tt.namespace, got)
+			}
+		})
+	}
+}
+
+func TestAccProviderNamespace_resource(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+	policyName := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderNamespaceConfig(namespacePath, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "namespace", namespacePath),
+					testAccProviderNamespaceCheckPolicy(namespacePath, policyName),
+				),
+			},
+			{
+				ResourceName:      "vault_policy.test",
+				ImportState:       true,
+				ImportStateId:     namespacePath + namespaceImportSeparator + policyName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProviderNamespaceCheckPolicy(namespacePath, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().Read("sys/policy/" + policyName)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("policy %q was created outside of namespace %q", policyName, namespacePath)
+		}
+
+		nsClient, err := namespaceClient(client, namespacePath)
+		if err != nil {
+			return err
+		}
+		resp, err = nsClient.(*api.Client).Logical().Read("sys/policy/" + policyName)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("policy %q not found in namespace %q", policyName, namespacePath)
+		}
+
+		return nil
+	}
+}
+
+func testAccProviderNamespaceConfig(namespacePath, policyName string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = %q
+}
+
+resource "vault_policy" "test" {
+  namespace = vault_namespace.test.path
+  name      = %q
+  policy    = <<EOT
+path "secret/*" {
+  capabilities = ["list"]
+}
+EOT
+}
+`, namespacePath, policyName)
+}
@@ -3,14 +3,19 @@ package vault
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
 	"github.com/mitchellh/go-homedir"
 )
@@ -66,6 +71,58 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testHTTPClient returns a client of a test server that serves handler, for
+// unit tests of the requests sent to Vault.
+func testHTTPClient(t *testing.T, handler http.HandlerFunc) *api.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	config.MaxRetries = 0
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("test")
+
+	return client
+}
+
+// testAccSkipIfVaultVersionBefore skips the test when the Vault server of the
+// acceptance tests is older than major.minor, e.g. for features that were
+// added in a later version of Vault.
+func testAccSkipIfVaultVersionBefore(t *testing.T, major, minor int) {
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, err := client.Sys().SealStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(status.Version, "v"), ".", 3)
+	if len(parts) < 2 {
+		t.Fatalf("unable to parse Vault version %q", status.Version)
+	}
+	gotMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		t.Fatalf("unable to parse Vault version %q: %s", status.Version, err)
+	}
+	gotMinor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		t.Fatalf("unable to parse Vault version %q: %s", status.Version, err)
+	}
+
+	if gotMajor < major || (gotMajor == major && gotMinor < minor) {
+		t.Skipf("Vault %s is older than %d.%d, which the test requires", status.Version, major, minor)
+	}
+}
+
 func getTestAWSCreds(t *testing.T) (string, string) {
 	accessKey := os.Getenv("AWS_ACCESS_KEY_ID")
 	secretKey := os.Getenv("AWS_SECRET_ACCESS_KEY")
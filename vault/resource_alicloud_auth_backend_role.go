@@ -149,7 +149,9 @@ func alicloudAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("role", role)
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	for _, k := range []string{"arn"} {
 		if v, ok := resp.Data[k]; ok {
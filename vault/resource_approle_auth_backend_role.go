@@ -1,28 +1,34 @@
 package vault
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
+// Role names can't contain slashes, so everything between "auth/" and the
+// last "/role/" is the backend, which may span several path segments.
 var (
-	approleAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
-	approleAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
+	approleAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/[^/]+$")
+	approleAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/([^/]+)$")
 )
 
 func approleAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"role_name": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Name of the role.",
-			ForceNew:    true,
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Name of the role.",
+			ForceNew:     true,
+			ValidateFunc: validateGenericName,
 		},
 		"role_id": {
 			Type:        schema.TypeString,
@@ -36,6 +42,12 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Default:     true,
 			Description: "Whether or not to require secret_id to be present when logging in using this AppRole.",
 		},
+		"local_secret_ids": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "If true, the SecretIDs generated using this role will be cluster local. This can only be set during role creation.",
+		},
 		"bound_cidr_list": {
 			Type:        schema.TypeSet,
 			Optional:    true,
@@ -53,7 +65,9 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Elem: &schema.Schema{
 				Type: schema.TypeString,
 			},
-			ConflictsWith: []string{"bound_cidr_list"},
+			Set:              hashTokenBoundCIDR,
+			DiffSuppressFunc: suppressEquivalentCIDRDiff,
+			ConflictsWith:    []string{"bound_cidr_list"},
 		},
 		"secret_id_num_uses": {
 			Type:        schema.TypeInt,
@@ -65,6 +79,28 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "Number of seconds a SecretID remains valid for.",
 		},
+		"token_policies_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of policies set on tokens issued using this AppRole.",
+		},
+		"secret_id_bound_cidrs_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of CIDR blocks that can log in using the AppRole.",
+		},
+		"validate_only": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If true, the role is not written to Vault. Setting it to false writes the role.",
+		},
+		"log_payload": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "If true, the payload that would be written is logged when validate_only is set.",
+		},
 		"backend": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -102,15 +138,32 @@ func approleAuthBackendRoleResource() *schema.Resource {
 		TokenPeriodConflict:   []string{"period"},
 	})
 
+	periodFields := []string{"token_period", "period"}
+	maxTTLFields := []string{"token_max_ttl", "token_explicit_max_ttl"}
+
 	return &schema.Resource{
-		Create: approleAuthBackendRoleCreate,
-		Read:   approleAuthBackendRoleRead,
-		Update: approleAuthBackendRoleUpdate,
-		Delete: approleAuthBackendRoleDelete,
-		Exists: approleAuthBackendRoleExists,
+		CreateContext: tokenPeriodWarning(approleAuthBackendRoleCreate, periodFields, maxTTLFields),
+		Read:          approleAuthBackendRoleRead,
+		UpdateContext: tokenPeriodWarning(approleAuthBackendRoleUpdate, periodFields, maxTTLFields),
+		Delete:        approleAuthBackendRoleDelete,
+		Exists:        approleAuthBackendRoleExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: approleAuthBackendRoleImport,
 		},
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Id() != "" && d.HasChange("validate_only") && d.Get("validate_only").(bool) {
+					return fmt.Errorf("validate_only cannot be enabled on an AppRole auth backend role that was already written to Vault")
+				}
+				return nil
+			},
+			customdiff.ComputedIf("token_policies_count", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("token_policies") || d.HasChange("policies")
+			}),
+			customdiff.ComputedIf("secret_id_bound_cidrs_count", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("secret_id_bound_cidrs") || d.HasChange("bound_cidr_list")
+			}),
+		),
 		Schema: fields,
 	}
 }
@@ -123,11 +176,17 @@ func approleAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 			data["bind_secret_id"] = v.(bool)
 		}
 
-		if v, ok := d.GetOk("secret_id_num_uses"); ok {
+		if v, ok := d.GetOk("local_secret_ids"); ok {
+			data["local_secret_ids"] = v.(bool)
+		}
+
+		// An explicit 0 means unlimited and must be sent as such, rather
+		// than being treated as unset.
+		if v, ok := d.GetOkExists("secret_id_num_uses"); ok {
 			data["secret_id_num_uses"] = v.(int)
 		}
 
-		if v, ok := d.GetOk("secret_id_ttl"); ok {
+		if v, ok := d.GetOkExists("secret_id_ttl"); ok {
 			data["secret_id_ttl"] = v.(int)
 		}
 
@@ -173,12 +232,31 @@ func approleAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 			data["policies"] = d.Get("policies").(*schema.Set).List()
 		}
 
+		// Moving between a deprecated field and its replacement clears the
+		// other one, which must not be sent, as Vault would apply the
+		// cleared value to both.
+		approleAuthBackendRoleDropCleared(d, data, "policies", "token_policies")
+		approleAuthBackendRoleDropCleared(d, data, "period", "token_period")
+
 		if d.HasChange("bound_cidr_list") {
 			data["bound_cidr_list"] = d.Get("bound_cidr_list").(*schema.Set).List()
 		}
 	}
 }
 
+// approleAuthBackendRoleDropCleared removes whichever of the deprecated field
+// and its replacement is being cleared from data, if the other one is set.
+func approleAuthBackendRoleDropCleared(d *schema.ResourceData, data map[string]interface{}, deprecated, replacement string) {
+	_, deprecatedSet := d.GetOk(deprecated)
+	_, replacementSet := d.GetOk(replacement)
+	switch {
+	case deprecatedSet && !replacementSet:
+		delete(data, replacement)
+	case replacementSet && !deprecatedSet:
+		delete(data, deprecated)
+	}
+}
+
 func approleAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -192,19 +270,34 @@ func approleAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) erro
 	data := map[string]interface{}{}
 	approleAuthBackendRoleUpdateFields(d, data, true)
 
-	_, err := client.Logical().Write(path, data)
+	if d.Get("validate_only").(bool) {
+		approleAuthBackendRoleLogPayload(d, path, data)
+		d.SetId(path)
+		return nil
+	}
+
+	_, err := authMountRetryWrite(client, path, data)
 	if err != nil {
-		return fmt.Errorf("error writing AppRole auth backend role %q: %s", path, err)
+		return fmt.Errorf("error writing AppRole auth backend role %q: %s", path, approleAuthBackendRoleTokenTypeError(d, err))
 	}
 	d.SetId(path)
 	log.Printf("[DEBUG] Wrote AppRole auth backend role %q", path)
 
 	if v, ok := d.GetOk("role_id"); ok {
+		// The client already retries transient failures with backoff,
+		// according to the provider's max_retries. If the RoleID still
+		// cannot be written the role is left in place, but with the ID
+		// set the error taints the resource so that the next apply
+		// replaces it. The actual RoleID is read back first, so that the
+		// state does not claim the configured one was applied.
 		log.Printf("[DEBUG] Writing AppRole auth backend role %q RoleID", path)
 		_, err := client.Logical().Write(path+"/role-id", map[string]interface{}{
 			"role_id": v.(string),
 		})
 		if err != nil {
+			if readErr := approleAuthBackendRoleRead(d, meta); readErr != nil {
+				log.Printf("[WARN] Error reading AppRole auth backend role %q after failing to write its RoleID: %s", path, readErr)
+			}
 			return fmt.Errorf("error writing AppRole auth backend role %q's RoleID: %s", path, err)
 		}
 		log.Printf("[DEBUG] Wrote AppRole auth backend role %q RoleID", path)
@@ -217,6 +310,11 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	if d.Get("validate_only").(bool) {
+		log.Printf("[DEBUG] AppRole auth backend role %q is only validated, not reading it", path)
+		return nil
+	}
+
 	backend, err := approleAuthBackendRoleBackendFromPath(path)
 	if err != nil {
 		return fmt.Errorf("invalid path %q for AppRole auth backend role: %s", path, err)
@@ -241,24 +339,32 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
-	readTokenFields(d, resp)
+
+	// Decide which of the deprecated fields are in use before the token
+	// fields are read, as those always include their replacements.
+	deprecatedPolicies := approleAuthBackendRoleUsesDeprecated(d, "policies", "token_policies")
+	deprecatedPeriod := approleAuthBackendRoleUsesDeprecated(d, "period", "token_period")
+
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	// Backward Compatability for Vault < 1.2
 	// Check if the user is using the deprecated `bound_cidr_list`
 	if _, deprecated := d.GetOk("bound_cidr_list"); deprecated {
 		if v, ok := resp.Data["bound_cidr_list"]; ok {
-			d.Set("bound_cidr_list", v)
+			d.Set("bound_cidr_list", approleAuthBackendRoleCIDRs(v))
 		} else if v, ok := resp.Data["secret_id_bound_cidrs"]; ok {
-			d.Set("bound_cidr_list", v)
+			d.Set("bound_cidr_list", approleAuthBackendRoleCIDRs(v))
 		}
 	} else {
 		if v, ok := resp.Data["secret_id_bound_cidrs"]; ok {
-			d.Set("secret_id_bound_cidrs", v)
+			d.Set("secret_id_bound_cidrs", approleAuthBackendRoleCIDRs(v))
 		}
 	}
 
 	// Check if the user is using the deprecated `policies`
-	if _, deprecated := d.GetOk("policies"); deprecated {
+	if deprecatedPolicies {
 		// Then we see if `token_policies` was set and unset it
 		// Vault will still return `policies`
 		if _, ok := d.GetOk("token_policies"); ok {
@@ -267,10 +373,12 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		if v, ok := resp.Data["policies"]; ok {
 			d.Set("policies", v)
 		}
+	} else {
+		d.Set("policies", nil)
 	}
 
 	// Check if the user is using the deprecated `period`
-	if _, deprecated := d.GetOk("period"); deprecated {
+	if deprecatedPeriod {
 		// Then we see if `token_period` was set and unset it
 		// Vault will still return `period`
 		if _, ok := d.GetOk("token_period"); ok {
@@ -279,7 +387,27 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		if v, ok := resp.Data["period"]; ok {
 			d.Set("period", v)
 		}
+	} else {
+		d.Set("period", nil)
+	}
+
+	// The counts are taken from what Vault reports, whichever of the
+	// deprecated fields or their replacements are in use.
+	policies := resp.Data["token_policies"]
+	if policies == nil {
+		policies = resp.Data["policies"]
+	}
+	policiesCount := 0
+	if v, ok := policies.([]interface{}); ok {
+		policiesCount = len(v)
 	}
+	d.Set("token_policies_count", policiesCount)
+
+	cidrs := resp.Data["secret_id_bound_cidrs"]
+	if cidrs == nil {
+		cidrs = resp.Data["bound_cidr_list"]
+	}
+	d.Set("secret_id_bound_cidrs_count", len(approleAuthBackendRoleCIDRs(cidrs)))
 
 	for _, k := range []string{"bind_secret_id", "secret_id_num_uses", "secret_id_ttl"} {
 		if err := d.Set(k, resp.Data[k]); err != nil {
@@ -287,6 +415,13 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	// Older versions of Vault do not report local_secret_ids.
+	if v, ok := resp.Data["local_secret_ids"]; ok {
+		if err := d.Set("local_secret_ids", v); err != nil {
+			return fmt.Errorf("error setting state key \"local_secret_ids\": %s", err)
+		}
+	}
+
 	log.Printf("[DEBUG] Reading AppRole auth backend role %q RoleID", path)
 	resp, err = client.Logical().Read(path + "/role-id")
 	if err != nil {
@@ -300,21 +435,69 @@ func approleAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// approleAuthBackendRoleCIDRs returns the CIDRs of a role as a list. Some
+// versions of Vault return them as a comma-separated string instead.
+func approleAuthBackendRoleCIDRs(v interface{}) []interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		return v
+	case string:
+		var cidrs []interface{}
+		for _, cidr := range strings.Split(v, ",") {
+			if cidr = strings.TrimSpace(cidr); cidr != "" {
+				cidrs = append(cidrs, cidr)
+			}
+		}
+		return cidrs
+	default:
+		return nil
+	}
+}
+
+// approleAuthBackendRoleUsesDeprecated reports whether the deprecated field is
+// in use instead of its replacement. Only one of them can be configured, but
+// state written by older versions of the provider, or imported from Vault
+// < 1.2, can contain both. In that case the replacement wins, so that the
+// deprecated field is cleared rather than shown in every plan.
+func approleAuthBackendRoleUsesDeprecated(d *schema.ResourceData, deprecated, replacement string) bool {
+	if _, ok := d.GetOk(deprecated); !ok {
+		return false
+	}
+	_, ok := d.GetOk(replacement)
+	return !ok
+}
+
 func approleAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	// local_secret_ids is ForceNew, so this only guards against the
+	// role being updated in place with a change Vault would ignore.
+	if d.HasChange("local_secret_ids") {
+		return fmt.Errorf("local_secret_ids of AppRole auth backend role %q cannot be changed after creation, the role must be recreated", path)
+	}
+
+	// A role that was only validated so far is written for the first time.
+	if d.HasChange("validate_only") && !d.Get("validate_only").(bool) {
+		return approleAuthBackendRoleCreate(d, meta)
+	}
+
 	log.Printf("[DEBUG] Updating AppRole auth backend role %q", path)
 
 	data := map[string]interface{}{}
 	approleAuthBackendRoleUpdateFields(d, data, false)
 
+	if d.Get("validate_only").(bool) {
+		approleAuthBackendRoleLogPayload(d, path, data)
+		return nil
+	}
+
 	_, err := client.Logical().Write(path, data)
 
 	d.SetId(path)
 
 	if err != nil {
-		return fmt.Errorf("error updating AppRole auth backend role %q: %s", path, err)
+		return fmt.Errorf("error updating AppRole auth backend role %q: %s", path, approleAuthBackendRoleTokenTypeError(d, err))
 	}
 	log.Printf("[DEBUG] Updated AppRole auth backend role %q", path)
 
@@ -337,6 +520,10 @@ func approleAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) erro
 	client := meta.(*api.Client)
 	path := d.Id()
 
+	if d.Get("validate_only").(bool) {
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting AppRole auth backend role %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil && !util.Is404(err) {
@@ -355,6 +542,10 @@ func approleAuthBackendRoleExists(d *schema.ResourceData, meta interface{}) (boo
 	client := meta.(*api.Client)
 
 	path := d.Id()
+	if d.Get("validate_only").(bool) {
+		return true, nil
+	}
+
 	log.Printf("[DEBUG] Checking if AppRole auth backend role %q exists", path)
 
 	resp, err := client.Logical().Read(path)
@@ -366,6 +557,38 @@ func approleAuthBackendRoleExists(d *schema.ResourceData, meta interface{}) (boo
 	return resp != nil, nil
 }
 
+// approleAuthBackendRoleImport accepts the path of the role, e.g.
+// "auth/team/approle/role/foo", ignoring any leading or trailing slashes.
+func approleAuthBackendRoleImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	path := strings.Trim(d.Id(), "/")
+
+	if _, err := approleAuthBackendRoleBackendFromPath(path); err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected auth/<backend>/role/<role_name>: %s", d.Id(), err)
+	}
+	if _, err := approleAuthBackendRoleNameFromPath(path); err != nil {
+		return nil, fmt.Errorf("invalid import ID %q, expected auth/<backend>/role/<role_name>: %s", d.Id(), err)
+	}
+	d.SetId(path)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// approleAuthBackendRoleLogPayload logs the payload that would have been
+// written to path, for roles with validate_only set, if log_payload is set.
+func approleAuthBackendRoleLogPayload(d *schema.ResourceData, path string, data map[string]interface{}) {
+	if !d.Get("log_payload").(bool) {
+		log.Printf("[DEBUG] validate_only is set, not writing AppRole auth backend role %q", path)
+		return
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		log.Printf("[WARN] Unable to encode the payload of AppRole auth backend role %q: %s", path, err)
+		return
+	}
+	log.Printf("[INFO] validate_only is set, not writing AppRole auth backend role %q: %s", path, payload)
+}
+
 func approleAuthBackendRolePath(backend, role string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(role, "/")
 }
@@ -391,3 +614,12 @@ func approleAuthBackendRoleBackendFromPath(path string) (string, error) {
 	}
 	return res[1], nil
 }
+
+// approleAuthBackendRoleTokenTypeError explains errors caused by a Vault
+// server that doesn't support token_type.
+func approleAuthBackendRoleTokenTypeError(d *schema.ResourceData, err error) error {
+	if !strings.Contains(err.Error(), "token_type") {
+		return err
+	}
+	return fmt.Errorf("token_type %q was rejected, it requires Vault 1.2 or later: %s", d.Get("token_type"), err)
+}
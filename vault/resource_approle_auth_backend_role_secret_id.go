@@ -1,13 +1,16 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -20,9 +23,12 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 	return &schema.Resource{
 		Create: approleAuthBackendRoleSecretIDCreate,
 		Read:   approleAuthBackendRoleSecretIDRead,
+		Update: approleAuthBackendRoleSecretIDUpdate,
 		Delete: approleAuthBackendRoleSecretIDDelete,
 		Exists: approleAuthBackendRoleSecretIDExists,
 
+		CustomizeDiff: approleAuthBackendRoleSecretIDCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -36,7 +42,6 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Description: "The SecretID to be managed. If not specified, Vault auto-generates one.",
-				ForceNew:    true,
 				Sensitive:   true,
 			},
 
@@ -94,6 +99,21 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Description: "The TTL duration of the wrapped SecretID.",
 			},
 
+			"rotate_before": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Number of seconds before the SecretID expires within which a new " +
+					"SecretID is issued in its place.",
+				ValidateFunc:  validation.IntAtLeast(0),
+				ConflictsWith: []string{"secret_id", "wrapping_ttl"},
+			},
+
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the SecretID expires, empty if it never expires.",
+			},
+
 			"wrapping_token": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -113,6 +133,38 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
+	if err := approleAuthBackendRoleSecretIDIssue(d, client); err != nil {
+		return err
+	}
+
+	return approleAuthBackendRoleSecretIDRead(d, meta)
+}
+
+func approleAuthBackendRoleSecretIDUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// A changed secret_id, or an accessor marked for rotation by
+	// approleAuthBackendRoleSecretIDCustomizeDiff, requires a new SecretID.
+	if d.HasChange("secret_id") || d.HasChange("accessor") {
+		oldID := d.Id()
+
+		// Issue the new SecretID before destroying the old one, so that
+		// logins keep working in between.
+		if err := approleAuthBackendRoleSecretIDIssue(d, client); err != nil {
+			return err
+		}
+		// The old SecretID may already have expired.
+		if err := approleAuthBackendRoleSecretIDDestroy(client, oldID); err != nil && !util.IsExpiredTokenErr(err) {
+			return err
+		}
+	}
+
+	return approleAuthBackendRoleSecretIDRead(d, meta)
+}
+
+// approleAuthBackendRoleSecretIDIssue writes a new SecretID and points the
+// resource ID at it.
+func approleAuthBackendRoleSecretIDIssue(d *schema.ResourceData, client *api.Client) error {
 	backend := d.Get("backend").(string)
 	role := d.Get("role_name").(string)
 
@@ -178,7 +230,7 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(approleAuthBackendRoleSecretIDID(backend, role, accessor, wrapped))
 
-	return approleAuthBackendRoleSecretIDRead(d, meta)
+	return nil
 }
 
 func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}) error {
@@ -254,12 +306,21 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	d.Set("metadata", string(metadata))
 	d.Set("accessor", accessor)
 
+	// Vault reports the zero time for SecretIDs that never expire.
+	expirationTime, _ := resp.Data["expiration_time"].(string)
+	if t, err := time.Parse(time.RFC3339Nano, expirationTime); err != nil || t.IsZero() {
+		expirationTime = ""
+	}
+	d.Set("expiration_time", expirationTime)
+
 	return nil
 }
 
 func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
-	id := d.Id()
+	return approleAuthBackendRoleSecretIDDestroy(meta.(*api.Client), d.Id())
+}
+
+func approleAuthBackendRoleSecretIDDestroy(client *api.Client, id string) error {
 	backend, role, accessor, wrapped, err := approleAuthBackendRoleSecretIDParseID(id)
 	if err != nil {
 		return fmt.Errorf("invalid ID %q for AppRole auth backend role SecretID: %s", id, err)
@@ -280,7 +341,7 @@ func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface
 		accessorParam: accessor,
 	})
 	if err != nil {
-		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q", id)
+		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Deleted AppRole auth backend role SecretID %q", id)
 
@@ -326,6 +387,43 @@ func approleAuthBackendRoleSecretIDExists(d *schema.ResourceData, meta interface
 	return resp != nil, nil
 }
 
+func approleAuthBackendRoleSecretIDCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	due, err := approleAuthBackendRoleSecretIDRotationDue(d.Get("expiration_time").(string), d.Get("rotate_before").(int), time.Now())
+	if err != nil {
+		return err
+	}
+	if !due {
+		return nil
+	}
+
+	log.Printf("[DEBUG] AppRole auth backend role SecretID %q expires within rotate_before, rotating", d.Id())
+	for _, k := range []string{"secret_id", "accessor", "expiration_time"} {
+		if err := d.SetNewComputed(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// approleAuthBackendRoleSecretIDRotationDue reports whether a SecretID
+// expiring at expirationTime is within rotateBefore seconds of now.
+func approleAuthBackendRoleSecretIDRotationDue(expirationTime string, rotateBefore int, now time.Time) (bool, error) {
+	if rotateBefore == 0 || expirationTime == "" {
+		return false, nil
+	}
+
+	expiration, err := time.Parse(time.RFC3339Nano, expirationTime)
+	if err != nil {
+		return false, fmt.Errorf("error parsing expiration_time %q: %s", expirationTime, err)
+	}
+
+	return now.Add(time.Duration(rotateBefore) * time.Second).After(expiration), nil
+}
+
 func approleAuthBackendRoleSecretIDID(backend, role, accessor string, wrapped bool) string {
 	if wrapped {
 		accessor = "wrapped-" + accessor
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,6 +37,115 @@ func TestAccAppRoleAuthBackendRoleSecretID_basic(t *testing.T) {
 	})
 }
 
+func TestAccAppRoleAuthBackendRoleSecretID_rotate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	var accessor string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleSecretIDDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_rotate(backend, role, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(secretIDResource, "expiration_time"),
+					testAccAppRoleAuthBackendRoleSecretIDCheckAccessor(&accessor, false),
+				),
+			},
+			{
+				// The SecretID expires within the hour, so a new one is issued
+				// in place. It is then due again straight away.
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_rotate(backend, role, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAppRoleAuthBackendRoleSecretIDCheckAccessor(&accessor, true),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_rotate(backend, role, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(secretIDResource, "rotate_before", "60"),
+					testAccAppRoleAuthBackendRoleSecretIDCheckAccessor(&accessor, false),
+				),
+			},
+		},
+	})
+}
+
+func TestApproleAuthBackendRoleSecretIDRotationDue(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		expirationTime string
+		rotateBefore   int
+		want           bool
+	}{
+		{"disabled", "2021-06-01T12:00:30Z", 0, false},
+		{"never expires", "", 3600, false},
+		{"outside threshold", "2021-06-01T13:00:00Z", 60, false},
+		{"within threshold", "2021-06-01T12:00:30Z", 60, true},
+		{"expired", "2021-06-01T11:00:00Z", 60, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := approleAuthBackendRoleSecretIDRotationDue(tt.expirationTime, tt.rotateBefore, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("approleAuthBackendRoleSecretIDRotationDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := approleAuthBackendRoleSecretIDRotationDue("tomorrow", 60, now); err == nil {
+		t.Error("expected an error for an invalid expiration_time")
+	}
+}
+
+// testAccAppRoleAuthBackendRoleSecretIDCheckAccessor records the SecretID's
+// accessor and checks whether it changed since the previous step.
+func testAccAppRoleAuthBackendRoleSecretIDCheckAccessor(accessor *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[secretIDResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", secretIDResource)
+		}
+
+		current := rs.Primary.Attributes["accessor"]
+		if *accessor != "" && (current != *accessor) != changed {
+			return fmt.Errorf("expected accessor changed to be %t, previous %q, current %q", changed, *accessor, current)
+		}
+		*accessor = current
+		return nil
+	}
+}
+
+func testAccAppRoleAuthBackendRoleSecretIDConfig_rotate(backend, role string, rotateBefore int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend = vault_auth_backend.approle.path
+  role_name = "%s"
+  token_policies = ["default"]
+  secret_id_ttl = 3600
+}
+
+resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
+  role_name = vault_approle_auth_backend_role.role.role_name
+  backend = vault_auth_backend.approle.path
+  rotate_before = %d
+}`, backend, role, rotateBefore)
+}
+
 func TestAccAppRoleAuthBackendRoleSecretID_wrapped(t *testing.T) {
 	backend := acctest.RandomWithPrefix("approle")
 	role := acctest.RandomWithPrefix("test-role")
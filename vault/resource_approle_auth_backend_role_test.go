@@ -1,11 +1,16 @@
 package vault
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -117,6 +122,8 @@ func TestAccAppRoleAuthBackendRole_update(t *testing.T) {
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
 						"token_policies.#", "3"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
+						"token_policies_count", "3"),
 					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role.role",
 						"role_id"),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
@@ -135,6 +142,8 @@ func TestAccAppRoleAuthBackendRole_update(t *testing.T) {
 						"bind_secret_id", "true"),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
 						"secret_id_bound_cidrs.#", "0"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
+						"secret_id_bound_cidrs_count", "0"),
 				),
 			},
 			{
@@ -146,6 +155,8 @@ func TestAccAppRoleAuthBackendRole_update(t *testing.T) {
 						"role_name", role),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
 						"token_policies.#", "2"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
+						"token_policies_count", "2"),
 					resource.TestCheckResourceAttrSet("vault_approle_auth_backend_role.role",
 						"role_id"),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
@@ -164,6 +175,8 @@ func TestAccAppRoleAuthBackendRole_update(t *testing.T) {
 						"bind_secret_id", "true"),
 					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
 						"secret_id_bound_cidrs.#", "0"),
+					resource.TestCheckResourceAttr("vault_approle_auth_backend_role.role",
+						"secret_id_bound_cidrs_count", "0"),
 				),
 			},
 		},
@@ -603,3 +616,800 @@ resource "vault_approle_auth_backend_role" "role" {
 	token_period = 86400
 }`, backend, role)
 }
+
+func TestAccAppRoleAuthBackendRole_tokenNoDefaultPolicy(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenNoDefaultPolicy(backend, role, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_no_default_policy", "true"),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "1"),
+					testAccAppRoleAuthBackendRoleCheck_loginPolicies(resourceName, []string{"dev"}),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenNoDefaultPolicy(backend, role, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_no_default_policy", "false"),
+					testAccAppRoleAuthBackendRoleCheck_loginPolicies(resourceName, []string{"default", "dev"}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRoleAuthBackendRole_tokenType(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppRoleAuthBackendRoleConfig_tokenType(backend, role, "ephemeral"),
+				ExpectError: regexp.MustCompile(`expected token_type to be one of`),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenType(backend, role, "batch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_type", "batch"),
+					testAccAppRoleAuthBackendRoleCheck_loginTokenType(resourceName, "batch"),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenType(backend, role, "service"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_type", "service"),
+					testAccAppRoleAuthBackendRoleCheck_loginTokenType(resourceName, "service"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAppRoleAuthBackendRole_localSecretIDs(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_localSecretIDs(backend, role, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "local_secret_ids", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Vault cannot change local_secret_ids, so the role is recreated.
+				Config: testAccAppRoleAuthBackendRoleConfig_localSecretIDs(backend, role, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "local_secret_ids", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRoleAuthBackendRole_tokenPeriodMaxTTL(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Vault accepts the combination, the provider only warns.
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenPeriod(backend, role, "token_period", "token_max_ttl"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_period", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "7200"),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_tokenPeriod(backend, role, "token_period", "token_ttl"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "token_period", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAppRoleAuthBackendRoleConfig_tokenPeriod(backend, role, periodField, ttlField string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend        = vault_auth_backend.approle.path
+  role_name      = "%s"
+  token_policies = ["dev"]
+  %s = 3600
+  %s = 7200
+}`, backend, role, periodField, ttlField)
+}
+
+func testAccAppRoleAuthBackendRoleConfig_localSecretIDs(backend, role string, localSecretIDs bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend          = vault_auth_backend.approle.path
+  role_name        = "%s"
+  token_policies   = ["dev"]
+  local_secret_ids = %t
+}`, backend, role, localSecretIDs)
+}
+
+// testAccAppRoleAuthBackendRoleCheck_loginTokenType logs in against the role
+// and verifies the type of the issued token.
+func testAccAppRoleAuthBackendRoleCheck_loginTokenType(resourceName, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		backend := rs.Primary.Attributes["backend"]
+		role := rs.Primary.Attributes["role_name"]
+
+		client := testProvider.Meta().(*api.Client)
+
+		secretID, err := client.Logical().Write(fmt.Sprintf("auth/%s/role/%s/secret-id", backend, role), nil)
+		if err != nil {
+			return fmt.Errorf("error generating secret ID for role %q: %s", role, err)
+		}
+
+		login, err := client.Logical().Write(fmt.Sprintf("auth/%s/login", backend), map[string]interface{}{
+			"role_id":   rs.Primary.Attributes["role_id"],
+			"secret_id": secretID.Data["secret_id"],
+		})
+		if err != nil {
+			return fmt.Errorf("error logging in with role %q: %s", role, err)
+		}
+
+		lookup, err := client.Auth().Token().Lookup(login.Auth.ClientToken)
+		if err != nil {
+			return fmt.Errorf("error looking up token issued by role %q: %s", role, err)
+		}
+		if lookup.Data["type"] != expected {
+			return fmt.Errorf("expected token type %q, got %v", expected, lookup.Data["type"])
+		}
+
+		return nil
+	}
+}
+
+func testAccAppRoleAuthBackendRoleConfig_tokenType(backend, role, tokenType string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend        = vault_auth_backend.approle.path
+  role_name      = "%s"
+  token_policies = ["dev"]
+  token_type     = "%s"
+}`, backend, role, tokenType)
+}
+
+// testAccAppRoleAuthBackendRoleCheck_loginPolicies logs in against the role
+// and verifies that the issued token has exactly the expected policies.
+func testAccAppRoleAuthBackendRoleCheck_loginPolicies(resourceName string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		backend := rs.Primary.Attributes["backend"]
+		role := rs.Primary.Attributes["role_name"]
+		roleID := rs.Primary.Attributes["role_id"]
+
+		client := testProvider.Meta().(*api.Client)
+
+		secretID, err := client.Logical().Write(fmt.Sprintf("auth/%s/role/%s/secret-id", backend, role), nil)
+		if err != nil {
+			return fmt.Errorf("error generating secret ID for role %q: %s", role, err)
+		}
+
+		login, err := client.Logical().Write(fmt.Sprintf("auth/%s/login", backend), map[string]interface{}{
+			"role_id":   roleID,
+			"secret_id": secretID.Data["secret_id"],
+		})
+		if err != nil {
+			return fmt.Errorf("error logging in with role %q: %s", role, err)
+		}
+		defer client.Auth().Token().RevokeAccessor(login.Auth.Accessor)
+
+		if len(login.Auth.TokenPolicies) != len(expected) {
+			return fmt.Errorf("expected token policies %v, got %v", expected, login.Auth.TokenPolicies)
+		}
+		for i, policy := range expected {
+			if login.Auth.TokenPolicies[i] != policy {
+				return fmt.Errorf("expected token policies %v, got %v", expected, login.Auth.TokenPolicies)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAppRoleAuthBackendRoleConfig_tokenNoDefaultPolicy(backend, role string, noDefaultPolicy bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend                 = vault_auth_backend.approle.path
+  role_name               = "%s"
+  token_policies          = ["dev"]
+  token_no_default_policy = %t
+}`, backend, role, noDefaultPolicy)
+}
+
+func TestAccAppRoleAuthBackendRole_zeroSecretID(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_secretID(backend, role, 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_id_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "secret_id_num_uses", "0"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "0"),
+					testAccAppRoleAuthBackendRoleCheck_vaultFields(resourceName, map[string]string{
+						"secret_id_ttl":      "0",
+						"secret_id_num_uses": "0",
+						"token_ttl":          "0",
+					}),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_secretID(backend, role, 600, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_id_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "secret_id_num_uses", "5"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "600"),
+				),
+			},
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_secretID(backend, role, 0, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_id_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "secret_id_num_uses", "0"),
+					resource.TestCheckResourceAttr(resourceName, "token_ttl", "0"),
+					testAccAppRoleAuthBackendRoleCheck_vaultFields(resourceName, map[string]string{
+						"secret_id_ttl":      "0",
+						"secret_id_num_uses": "0",
+						"token_ttl":          "0",
+					}),
+				),
+			},
+			{
+				// An explicit 0 must not produce a perpetual diff.
+				Config:             testAccAppRoleAuthBackendRoleConfig_secretID(backend, role, 0, 0),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+// testAccAppRoleAuthBackendRoleCheck_vaultFields verifies the role's fields as
+// stored in Vault.
+func testAccAppRoleAuthBackendRoleCheck_vaultFields(resourceName string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error reading AppRole auth backend role %q: %s", rs.Primary.ID, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("AppRole auth backend role %q not found", rs.Primary.ID)
+		}
+
+		for k, want := range expected {
+			if got := fmt.Sprintf("%v", resp.Data[k]); got != want {
+				return fmt.Errorf("expected %s of AppRole auth backend role %q to be %q, got %q", k, rs.Primary.ID, want, got)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccAppRoleAuthBackendRoleConfig_secretID(backend, role string, ttl, numUses int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend            = vault_auth_backend.approle.path
+  role_name          = "%s"
+  token_policies     = ["dev"]
+  token_ttl          = %d
+  secret_id_ttl      = %d
+  secret_id_num_uses = %d
+}`, backend, role, ttl, ttl, numUses)
+}
+
+func TestAccAppRoleAuthBackendRole_importNestedBackend(t *testing.T) {
+	backend := acctest.RandomWithPrefix("team") + "/approle"
+	role := acctest.RandomWithPrefix("test-role")
+	resourceName := "vault_approle_auth_backend_role.role"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAppRoleAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppRoleAuthBackendRoleConfig_basic(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role_name", role),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "/auth/" + backend + "/role/" + role + "/",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAppRoleAuthBackendRoleFromPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		backend string
+		role    string
+		wantErr bool
+	}{
+		{
+			path:    "auth/approle/role/foo",
+			backend: "approle",
+			role:    "foo",
+		},
+		{
+			path:    "auth/a/b/role/foo",
+			backend: "a/b",
+			role:    "foo",
+		},
+		{
+			path:    "auth/team/approle/role/foo",
+			backend: "team/approle",
+			role:    "foo",
+		},
+		{
+			path:    "auth/team/role/approle/role/foo",
+			backend: "team/role/approle",
+			role:    "foo",
+		},
+		{
+			path:    "auth/approle/role/",
+			wantErr: true,
+		},
+		{
+			path:    "auth/role/foo",
+			wantErr: true,
+		},
+		{
+			path:    "approle/role/foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			backend, err := approleAuthBackendRoleBackendFromPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("approleAuthBackendRoleBackendFromPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			role, err := approleAuthBackendRoleNameFromPath(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("approleAuthBackendRoleNameFromPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if backend != tt.backend {
+				t.Errorf("expected backend %q, got %q", tt.backend, backend)
+			}
+			if role != tt.role {
+				t.Errorf("expected role %q, got %q", tt.role, role)
+			}
+			if path := approleAuthBackendRolePath(backend, role); path != tt.path {
+				t.Errorf("expected path %q, got %q", tt.path, path)
+			}
+		})
+	}
+}
+
+func TestAppRoleAuthBackendRoleImport(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    string
+		wantErr bool
+	}{
+		{
+			id:   "auth/a/b/role/foo",
+			want: "auth/a/b/role/foo",
+		},
+		{
+			id:   "/auth/a/b/role/foo/",
+			want: "auth/a/b/role/foo",
+		},
+		{
+			id:      "a/b/role/foo",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			d := approleAuthBackendRoleResource().TestResourceData()
+			d.SetId(tt.id)
+
+			result, err := approleAuthBackendRoleImport(d, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("approleAuthBackendRoleImport() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(result) != 1 || result[0].Id() != tt.want {
+				t.Errorf("expected ID %q, got %q", tt.want, result[0].Id())
+			}
+		})
+	}
+}
+
+func TestAccAppRoleAuthBackendRole_invalidRoleName(t *testing.T) {
+	backend := acctest.RandomWithPrefix("approle")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAppRoleAuthBackendRoleConfig_basic(backend, "foo/bar"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected role_name to only contain letters, digits, underscores, dashes and dots`),
+			},
+		},
+	})
+}
+
+func TestAppRoleAuthBackendRoleRead_deprecatedFields(t *testing.T) {
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/auth/approle/role/test":
+			fmt.Fprint(w, `{"data":{"policies":["dev"],"token_policies":["dev"],"period":60,"token_period":60,`+
+				`"bind_secret_id":true,"secret_id_num_uses":0,"secret_id_ttl":0}}`)
+		case "/v1/auth/approle/role/test/role-id":
+			fmt.Fprint(w, `{"data":{"role_id":"role-id"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	tests := []struct {
+		name          string
+		state         map[string]interface{}
+		policies      int
+		tokenPolicies int
+		period        int
+		tokenPeriod   int
+	}{
+		{
+			name:          "imported",
+			state:         map[string]interface{}{},
+			tokenPolicies: 1,
+			tokenPeriod:   60,
+		},
+		{
+			name: "deprecated",
+			state: map[string]interface{}{
+				"policies": []interface{}{"dev"},
+				"period":   60,
+			},
+			policies: 1,
+			period:   60,
+		},
+		{
+			name: "current",
+			state: map[string]interface{}{
+				"token_policies": []interface{}{"dev"},
+				"token_period":   60,
+			},
+			tokenPolicies: 1,
+			tokenPeriod:   60,
+		},
+		{
+			name: "legacy state with both",
+			state: map[string]interface{}{
+				"policies":       []interface{}{"dev"},
+				"token_policies": []interface{}{"dev"},
+				"period":         60,
+				"token_period":   60,
+			},
+			tokenPolicies: 1,
+			tokenPeriod:   60,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := approleAuthBackendRoleResource().TestResourceData()
+			d.SetId("auth/approle/role/test")
+			for k, v := range tt.state {
+				if err := d.Set(k, v); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := approleAuthBackendRoleRead(d, client); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := d.Get("policies").(*schema.Set).Len(); got != tt.policies {
+				t.Errorf("expected %d policies, got %d", tt.policies, got)
+			}
+			if got := d.Get("token_policies").(*schema.Set).Len(); got != tt.tokenPolicies {
+				t.Errorf("expected %d token_policies, got %d", tt.tokenPolicies, got)
+			}
+			if got := d.Get("period").(int); got != tt.period {
+				t.Errorf("expected period %d, got %d", tt.period, got)
+			}
+			if got := d.Get("token_period").(int); got != tt.tokenPeriod {
+				t.Errorf("expected token_period %d, got %d", tt.tokenPeriod, got)
+			}
+			// The count reflects the policies in Vault, whichever field holds them.
+			if got := d.Get("token_policies_count").(int); got != 1 {
+				t.Errorf("expected token_policies_count 1, got %d", got)
+			}
+		})
+	}
+}
+
+func TestAppRoleAuthBackendRole_validateOnly(t *testing.T) {
+	requests := 0
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	r := approleAuthBackendRoleResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"role_name":      "test",
+		"validate_only":  true,
+		"token_policies": []interface{}{"dev"},
+		"secret_id_ttl":  600,
+	})
+
+	if err := approleAuthBackendRoleCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "auth/approle/role/test" {
+		t.Errorf("expected ID %q, got %q", "auth/approle/role/test", d.Id())
+	}
+
+	if err := approleAuthBackendRoleRead(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if exists, err := approleAuthBackendRoleExists(d, client); err != nil || !exists {
+		t.Errorf("expected the role to exist, got %t, %v", exists, err)
+	}
+	if err := approleAuthBackendRoleUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if err := approleAuthBackendRoleDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 0 {
+		t.Errorf("expected no requests to Vault, got %d", requests)
+	}
+}
+
+func TestAppRoleAuthBackendRole_validateOnlyUpgrade(t *testing.T) {
+	r := approleAuthBackendRoleResource()
+	// State written by a version of the provider without validate_only.
+	state := &terraform.InstanceState{
+		ID: "auth/approle/role/test",
+		Attributes: map[string]string{
+			"id":        "auth/approle/role/test",
+			"backend":   "approle",
+			"role_name": "test",
+			"role_id":   "role-id",
+		},
+	}
+	c := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"role_name": "test",
+	})
+
+	diff, err := r.Diff(context.Background(), state, c, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected the role not to be replaced, got %#v", diff.Attributes)
+	}
+}
+
+func TestAppRoleAuthBackendRole_validateOnlyDisabled(t *testing.T) {
+	var writes []string
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut || r.Method == http.MethodPost {
+			writes = append(writes, r.URL.Path)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/auth/approle/role/test":
+			fmt.Fprint(w, `{"data":{"token_policies":["dev"],"bind_secret_id":true,"secret_id_num_uses":0,"secret_id_ttl":0}}`)
+		case "/v1/auth/approle/role/test/role-id":
+			fmt.Fprint(w, `{"data":{"role_id":"role-id"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := approleAuthBackendRoleResource()
+	rawConfig := func(validateOnly bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"role_name":      "test",
+			"role_id":        "role-id",
+			"validate_only":  validateOnly,
+			"token_policies": []interface{}{"dev"},
+		})
+	}
+	apply := func(state *terraform.InstanceState, validateOnly bool) *terraform.InstanceState {
+		diff, err := r.Diff(context.Background(), state, rawConfig(validateOnly), client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != nil && diff.RequiresNew() {
+			t.Fatalf("expected the role not to be replaced, got %#v", diff.Attributes)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return state
+	}
+
+	state := apply(nil, true)
+	if len(writes) != 0 {
+		t.Fatalf("expected no writes while validate_only is set, got %v", writes)
+	}
+
+	state = apply(state, false)
+	expected := []string{"/v1/auth/approle/role/test", "/v1/auth/approle/role/test/role-id"}
+	if !reflect.DeepEqual(writes, expected) {
+		t.Fatalf("expected writes %v, got %v", expected, writes)
+	}
+
+	// A role that was written cannot be switched back to validate_only.
+	if _, err := r.Diff(context.Background(), state, rawConfig(true), client); err == nil {
+		t.Fatal("expected an error enabling validate_only on a written role")
+	}
+}
+
+func TestAppRoleAuthBackendRoleRead_secretIDBoundCIDRs(t *testing.T) {
+	tests := []struct {
+		name  string
+		cidrs string
+	}{
+		{
+			name:  "list",
+			cidrs: `["10.148.0.0/20","10.150.0.1"]`,
+		},
+		{
+			name:  "comma-separated string",
+			cidrs: `"10.148.0.0/20, 10.150.0.1"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/v1/auth/approle/role/test":
+					fmt.Fprintf(w, `{"data":{"secret_id_bound_cidrs":%s,"bind_secret_id":true,`+
+						`"secret_id_num_uses":0,"secret_id_ttl":0}}`, tt.cidrs)
+				case "/v1/auth/approle/role/test/role-id":
+					fmt.Fprint(w, `{"data":{"role_id":"role-id"}}`)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := approleAuthBackendRoleResource().TestResourceData()
+			d.SetId("auth/approle/role/test")
+			expected := schema.NewSet(hashTokenBoundCIDR, []interface{}{"10.148.0.0/20", "10.150.0.1"})
+
+			// Reading twice must leave the state unchanged.
+			for i := 0; i < 2; i++ {
+				if err := approleAuthBackendRoleRead(d, client); err != nil {
+					t.Fatal(err)
+				}
+
+				got := d.Get("secret_id_bound_cidrs").(*schema.Set)
+				if !got.Equal(expected) {
+					t.Errorf("read %d: expected secret_id_bound_cidrs %v, got %v", i, expected.List(), got.List())
+				}
+				if count := d.Get("secret_id_bound_cidrs_count").(int); count != 2 {
+					t.Errorf("read %d: expected secret_id_bound_cidrs_count 2, got %d", i, count)
+				}
+			}
+		})
+	}
+}
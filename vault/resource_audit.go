@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,11 +51,38 @@ func auditResource() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 				Description: "Configuration options to pass to the audit device itself.",
+
+				DiffSuppressFunc: auditOptionsDiffSuppress,
 			},
 		},
 	}
 }
 
+// auditBoolOptions are the audit device options that Vault parses as
+// booleans, so "true", "True" and "1" are all the same setting.
+var auditBoolOptions = map[string]bool{
+	"log_raw":              true,
+	"hmac_accessor":        true,
+	"elide_list_responses": true,
+	"fallback":             true,
+}
+
+func auditOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if !auditBoolOptions[strings.TrimPrefix(k, "options.")] {
+		return false
+	}
+
+	oldBool, err := strconv.ParseBool(old)
+	if err != nil {
+		return false
+	}
+	newBool, err := strconv.ParseBool(new)
+	if err != nil {
+		return false
+	}
+	return oldBool == newBool
+}
+
 func auditWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
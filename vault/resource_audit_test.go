@@ -24,6 +24,70 @@ func TestResourceAudit(t *testing.T) {
 	})
 }
 
+func TestResourceAudit_boolOptions(t *testing.T) {
+	path := "example-" + acctest.RandString(10)
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAudit_boolOptionsConfig(path, "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_audit.test", "options.log_raw", "true"),
+					resource.TestCheckResourceAttr("vault_audit.test", "options.hmac_accessor", "false"),
+				),
+			},
+			{
+				Config:   testResourceAudit_boolOptionsConfig(path, "True"),
+				PlanOnly: true,
+			},
+			{
+				Config:   testResourceAudit_boolOptionsConfig(path, "1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAuditOptionsDiffSuppress(t *testing.T) {
+	tests := []struct {
+		key      string
+		old      string
+		new      string
+		expected bool
+	}{
+		{"options.log_raw", "true", "true", true},
+		{"options.log_raw", "true", "True", true},
+		{"options.log_raw", "1", "true", true},
+		{"options.hmac_accessor", "false", "0", true},
+		{"options.log_raw", "true", "false", false},
+		{"options.log_raw", "true", "", false},
+		{"options.path", "1", "true", false},
+		{"options.%", "2", "3", false},
+	}
+
+	for _, tt := range tests {
+		actual := auditOptionsDiffSuppress(tt.key, tt.old, tt.new, nil)
+		if actual != tt.expected {
+			t.Errorf("auditOptionsDiffSuppress(%q, %q, %q) = %t, expected %t", tt.key, tt.old, tt.new, actual, tt.expected)
+		}
+	}
+}
+
+func testResourceAudit_boolOptionsConfig(path, logRaw string) string {
+	return fmt.Sprintf(`
+resource "vault_audit" "test" {
+	path = "%s"
+	type = "file"
+	options = {
+		path          = "stdout"
+		log_raw       = "%s"
+		hmac_accessor = "false"
+	}
+}
+`, path, logRaw)
+}
+
 func testResourceAudit_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_audit" "test" {
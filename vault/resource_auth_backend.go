@@ -83,6 +83,7 @@ func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Writing auth %q to Vault", path)
+	authMountAccessorCacheInvalidate(client)
 	if err := client.Sys().EnableAuthWithOptions(path, options); err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
@@ -99,6 +100,7 @@ func authBackendDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
+	authMountAccessorCacheInvalidate(client)
 	if err := client.Sys().DisableAuth(path); err != nil {
 		return fmt.Errorf("error disabling auth from Vault: %s", err)
 	}
@@ -137,6 +139,20 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	log.Printf("[DEBUG] Reading auth tune from %q", "auth/"+path+"/tune")
+	rawTune, err := authMountTuneGet(client, "auth/"+path)
+	if err != nil {
+		return fmt.Errorf("error reading tune information from Vault: %s", err)
+	}
+	var prior []interface{}
+	if v, ok := d.Get("tune").(*schema.Set); ok {
+		prior = v.List()
+	}
+	if err := d.Set("tune", []map[string]interface{}{mergeAuthMountTune(prior, rawTune)}); err != nil {
+		log.Printf("[ERROR] Error when setting tune config from path %q to state: %s", "auth/"+path+"/tune", err)
+		return err
+	}
+
 	return nil
 }
 
@@ -154,7 +170,7 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			err := authMountTune(client, "auth/"+path, raw)
 			if err != nil {
-				return nil
+				return fmt.Errorf("error tuning auth %q: %s", path, err)
 			}
 
 			log.Printf("[INFO] Written %s auth tune to '%q'", backendType, path)
@@ -220,6 +220,38 @@ func TestResourceAuthTune(t *testing.T) {
 					checkAuthMount(backend, maxLeaseTtl(7200)),
 				),
 			},
+			{
+				Config: testResourceAuthTune_tokenTypeConfig(backend, "batch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuthFirst.Accessor),
+					resource.TestCheckResourceAttr(resName, "tune.#", "1"),
+					resource.TestCheckResourceAttr(resName, "tune.0.default_lease_ttl", "1h"),
+					resource.TestCheckResourceAttr(resName, "tune.0.max_lease_ttl", "90000s"),
+					resource.TestCheckResourceAttr(resName, "tune.0.listing_visibility", "hidden"),
+					resource.TestCheckResourceAttr(resName, "tune.0.token_type", "batch"),
+					resource.TestCheckResourceAttr(resName, "tune.0.audit_non_hmac_request_keys.#", "1"),
+					resource.TestCheckResourceAttr(resName, "tune.0.audit_non_hmac_request_keys.0", "username"),
+					checkAuthMount(backend, listingVisibility("hidden")),
+					checkAuthMount(backend, defaultLeaseTtl(3600)),
+					checkAuthMount(backend, maxLeaseTtl(90000)),
+				),
+			},
+			{
+				Config: testResourceAuthTune_tokenTypeConfig(backend, "default-batch"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "tune.0.token_type", "default-batch"),
+				),
+			},
+			{
+				Config: testResourceAuthTune_tokenTypeConfig(backend, "default"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "tune.0.token_type", "default"),
+				),
+			},
+			{
+				Config:   testResourceAuthTune_tokenTypeConfig(backend, "default"),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -249,6 +281,21 @@ resource "vault_auth_backend" "test" {
 }`, backend)
 }
 
+func testResourceAuthTune_tokenTypeConfig(backend, tokenType string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type = "github"
+	path = "%s"
+	tune {
+		default_lease_ttl           = "1h"
+		max_lease_ttl               = "90000s"
+		listing_visibility          = "hidden"
+		token_type                  = "%s"
+		audit_non_hmac_request_keys = ["username"]
+	}
+}`, backend, tokenType)
+}
+
 func checkAuthMount(backend string, checker func(*api.AuthMount) error) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testProvider.Meta().(*api.Client)
@@ -1,15 +1,28 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+// awsAuthBackendClientFields maps string fields in state to their
+// names in the Vault API.
+var awsAuthBackendClientFields = map[string]string{
+	"access_key":                 "access_key",
+	"ec2_endpoint":               "endpoint",
+	"iam_endpoint":               "iam_endpoint",
+	"sts_endpoint":               "sts_endpoint",
+	"sts_region":                 "sts_region",
+	"iam_server_id_header_value": "iam_server_id_header_value",
+}
+
 func awsAuthBackendClientResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsAuthBackendWrite,
@@ -70,6 +83,14 @@ func awsAuthBackendClientResource() *schema.Resource {
 				Optional:    true,
 				Description: "The value to require in the X-Vault-AWS-IAM-Server-ID header as part of GetCallerIdentity requests that are used in the iam auth method.",
 			},
+			"max_retries": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				Description: "Number of max retries the client should use for recoverable errors. " +
+					"The default of -1 falls back to the AWS SDK's default behavior.",
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
 		},
 	}
 }
@@ -97,6 +118,11 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"iam_server_id_header_value": iamServerIDHeaderValue,
 	}
 
+	// Only send max_retries when configured, so Vault's default is kept otherwise.
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		data["max_retries"] = v.(int)
+	}
+
 	if d.HasChange("access_key") || d.HasChange("secret_key") {
 		log.Printf("[DEBUG] Updating AWS credentials at %q", path)
 		data["access_key"] = d.Get("access_key").(string)
@@ -143,12 +169,24 @@ func awsAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("backend", re.FindStringSubmatch(d.Id())[1])
 
-	d.Set("access_key", secret.Data["access_key"])
-	d.Set("ec2_endpoint", secret.Data["endpoint"])
-	d.Set("iam_endpoint", secret.Data["iam_endpoint"])
-	d.Set("sts_endpoint", secret.Data["sts_endpoint"])
-	d.Set("sts_region", secret.Data["sts_region"])
-	d.Set("iam_server_id_header_value", secret.Data["iam_server_id_header_value"])
+	// Older Vault versions don't return every field, leave those untouched.
+	for k, apiKey := range awsAuthBackendClientFields {
+		if v, ok := secret.Data[apiKey]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	if v, ok := secret.Data["max_retries"]; ok {
+		n, _ := v.(json.Number)
+		maxRetries, err := n.Int64()
+		if err != nil {
+			return fmt.Errorf("expected max_retries %q to be a number", v)
+		}
+		d.Set("max_retries", maxRetries)
+	}
+
 	return nil
 }
 
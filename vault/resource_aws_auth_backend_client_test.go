@@ -41,11 +41,17 @@ func TestAccAWSAuthBackendClient_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccAWSAuthBackendClientConfig_basic(backend),
-				Check:  testAccAWSAuthBackendClientCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "5"),
+				),
 			},
 			{
 				Config: testAccAWSAuthBackendClientConfig_updated(backend),
-				Check:  testAccAWSAuthBackendClientCheck_attrs(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "max_retries", "-1"),
+				),
 			},
 		},
 	})
@@ -194,6 +200,7 @@ resource "vault_aws_auth_backend_client" "client" {
   sts_endpoint = "http://vault.test/sts"
   sts_region = "vault-test"
   iam_server_id_header_value = "vault.test"
+  max_retries = 5
 }
 `, backend)
 }
@@ -215,6 +222,7 @@ resource "vault_aws_auth_backend_client" "client" {
   sts_endpoint = "http://updated.vault.test/sts"
   sts_region = "updated-vault-test"
   iam_server_id_header_value = "updated.vault.test"
+  max_retries = -1
 }`, backend)
 }
 
@@ -372,7 +372,9 @@ func awsAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	// Check if the user is using the deprecated `policies`
 	if _, deprecated := d.GetOk("policies"); deprecated {
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// awsAuthBackendRoleTagDenylistFromPathRegex matches the API path of an
+// entry, which is accepted as import ID and was the resource ID of earlier
+// versions of the provider.
+var awsAuthBackendRoleTagDenylistFromPathRegex = regexp.MustCompile("^auth/(.+?)/roletag-denylist/(.+)$")
+
+func awsAuthBackendRoleTagDenylistResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendRoleTagDenylistCreate,
+		Read:   awsAuthBackendRoleTagDenylistRead,
+		Delete: awsAuthBackendRoleTagDenylistDelete,
+		Importer: &schema.ResourceImporter{
+			State: awsAuthBackendRoleTagDenylistImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role_tag": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The role tag value to deny.",
+				Sensitive:   true,
+			},
+			"creation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the role tag was added to the deny list.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the role tag's deny list entry expires and becomes eligible for tidying.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendRoleTagDenylistCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	roleTag := d.Get("role_tag").(string)
+
+	log.Printf("[DEBUG] Checking if role tag is already denied in AWS auth backend %q", backend)
+	resp, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodGet, backend, roleTag)
+	if err != nil {
+		return fmt.Errorf("error checking for denied role tag in AWS auth backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Checked if role tag is already denied in AWS auth backend %q", backend)
+
+	// A role tag that is already denied, e.g. by hand after a leak, is
+	// adopted rather than written again.
+	if resp == nil {
+		log.Printf("[DEBUG] Denying role tag in AWS auth backend %q", backend)
+		if _, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodPut, backend, roleTag); err != nil {
+			return fmt.Errorf("error denying role tag in AWS auth backend %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Denied role tag in AWS auth backend %q", backend)
+	}
+
+	d.SetId(awsAuthBackendRoleTagDenylistID(backend, roleTag))
+
+	return awsAuthBackendRoleTagDenylistRead(d, meta)
+}
+
+func awsAuthBackendRoleTagDenylistImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	res := awsAuthBackendRoleTagDenylistFromPathRegex.FindStringSubmatch(d.Id())
+	if len(res) != 3 {
+		return nil, fmt.Errorf("invalid import ID for AWS auth backend role tag deny list entry, expected auth/<backend>/roletag-denylist/<role_tag>")
+	}
+
+	d.Set("backend", res[1])
+	d.Set("role_tag", res[2])
+	d.SetId(awsAuthBackendRoleTagDenylistID(res[1], res[2]))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func awsAuthBackendRoleTagDenylistRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// Earlier versions of the provider used the API path, which contains the
+	// role tag, as ID.
+	if res := awsAuthBackendRoleTagDenylistFromPathRegex.FindStringSubmatch(d.Id()); len(res) == 3 {
+		log.Printf("[DEBUG] Upgrading the ID of an AWS auth backend role tag deny list entry")
+		d.Set("backend", res[1])
+		d.Set("role_tag", res[2])
+		d.SetId(awsAuthBackendRoleTagDenylistID(res[1], res[2]))
+	}
+
+	backend := d.Get("backend").(string)
+
+	log.Printf("[DEBUG] Reading denied role tag from AWS auth backend %q", backend)
+	resp, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodGet, backend, d.Get("role_tag").(string))
+	if err != nil {
+		return fmt.Errorf("error reading denied role tag from AWS auth backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read denied role tag from AWS auth backend %q", backend)
+
+	if resp == nil {
+		log.Printf("[WARN] Denied role tag not found in AWS auth backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("creation_time", resp.Data["creation_time"])
+	d.Set("expiration_time", resp.Data["expiration_time"])
+
+	return nil
+}
+
+func awsAuthBackendRoleTagDenylistDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	log.Printf("[DEBUG] Removing denied role tag %q", d.Id())
+	if _, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodDelete, backend, d.Get("role_tag").(string)); err != nil {
+		return fmt.Errorf("error removing denied role tag %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Removed denied role tag %q", d.Id())
+
+	return nil
+}
+
+// awsAuthBackendRoleTagDenylistID identifies an entry without revealing the
+// role tag, which is a credential.
+func awsAuthBackendRoleTagDenylistID(backend, roleTag string) string {
+	sum := sha256.Sum256([]byte(roleTag))
+	return strings.Trim(backend, "/") + "/" + hex.EncodeToString(sum[:])
+}
+
+// awsAuthBackendRoleTagDenylistRequest sends a request for the deny list
+// entry of roleTag, escaping the role tag in the path. A missing entry
+// returns no secret and no error.
+func awsAuthBackendRoleTagDenylistRequest(client *api.Client, method, backend, roleTag string) (*api.Secret, error) {
+	r := client.NewRequest(method, "/v1/auth/"+strings.Trim(backend, "/")+"/roletag-denylist")
+	// The api client escapes Path itself, so the escaped role tag is only
+	// added to RawPath.
+	r.URL.RawPath = r.URL.EscapedPath() + "/" + url.PathEscape(roleTag)
+	r.URL.Path += "/" + roleTag
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if resp != nil && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := api.ParseSecret(resp.Body)
+	if err == io.EOF {
+		return nil, nil
+	}
+	return secret, err
+}
@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccAWSAuthBackendRoleTagDenylist_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	role := acctest.RandomWithPrefix("tf-test-aws")
+	resourceName := "vault_aws_auth_backend_roletag_denylist.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckAWSAuthBackendRoleTagDenylistDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendRoleTagDenylistConfig_basic(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttrPair(resourceName, "role_tag",
+						"vault_aws_auth_backend_role_tag.test", "tag_value"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_time"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[resourceName]
+					return "auth/" + rs.Primary.Attributes["backend"] + "/roletag-denylist/" + rs.Primary.Attributes["role_tag"], nil
+				},
+			},
+		},
+	})
+}
+
+func TestAWSAuthBackendRoleTagDenylistRequest(t *testing.T) {
+	roleTag := "v1:09Vp0qGuyB8=:r=dev:p=default:a/b+c="
+
+	var requestURI string
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requestURI = r.RequestURI
+		if r.URL.Path != "/v1/auth/aws/roletag-denylist/"+roleTag {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"creation_time":"2021-01-01T00:00:00Z"}}`)
+	})
+
+	secret, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodGet, "/aws/", roleTag)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret == nil {
+		t.Fatalf("expected the entry to be found, requested %q", requestURI)
+	}
+
+	expected := "/v1/auth/aws/roletag-denylist/" + url.PathEscape(roleTag)
+	if requestURI != expected {
+		t.Errorf("expected request to %q, got %q", expected, requestURI)
+	}
+}
+
+func TestAWSAuthBackendRoleTagDenylistImport(t *testing.T) {
+	roleTag := "v1:09Vp0qGuyB8=:r=dev:p=default:a/b+c="
+
+	d := awsAuthBackendRoleTagDenylistResource().TestResourceData()
+	d.SetId("auth/nested/aws/roletag-denylist/" + roleTag)
+	if _, err := awsAuthBackendRoleTagDenylistImport(d, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(d.Id(), roleTag) {
+		t.Errorf("expected the ID not to contain the role tag, got %q", d.Id())
+	}
+	if d.Id() != awsAuthBackendRoleTagDenylistID("nested/aws", roleTag) {
+		t.Errorf("unexpected ID %q", d.Id())
+	}
+	if got := d.Get("backend").(string); got != "nested/aws" {
+		t.Errorf("expected backend %q, got %q", "nested/aws", got)
+	}
+	if got := d.Get("role_tag").(string); got != roleTag {
+		t.Errorf("expected role_tag %q, got %q", roleTag, got)
+	}
+
+	d.SetId("nested/aws")
+	if _, err := awsAuthBackendRoleTagDenylistImport(d, nil); err == nil {
+		t.Error("expected an error for an ID without a role tag")
+	}
+}
+
+func testAccCheckAWSAuthBackendRoleTagDenylistDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_aws_auth_backend_roletag_denylist" {
+			continue
+		}
+		secret, err := awsAuthBackendRoleTagDenylistRequest(client, http.MethodGet,
+			rs.Primary.Attributes["backend"], rs.Primary.Attributes["role_tag"])
+		if err != nil {
+			// The auth backend is removed along with the entry.
+			continue
+		}
+		if secret != nil {
+			return fmt.Errorf("role tag %q still denied", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccAWSAuthBackendRoleTagDenylistConfig_basic(backend, role string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+    path = "%s"
+    type = "aws"
+}
+
+resource "vault_aws_auth_backend_role" "role" {
+    backend = vault_auth_backend.aws.path
+    role = "%s"
+    auth_type = "ec2"
+    bound_account_ids = ["123456789012"]
+    policies = ["dev", "prod"]
+    role_tag = "VaultRoleTag"
+}
+
+resource "vault_aws_auth_backend_role_tag" "test" {
+    backend = vault_auth_backend.aws.path
+    role = vault_aws_auth_backend_role.role.role
+    policies = ["dev"]
+    max_ttl = "1h"
+}
+
+resource "vault_aws_auth_backend_roletag_denylist" "test" {
+    backend = vault_auth_backend.aws.path
+    role_tag = vault_aws_auth_backend_role_tag.test.tag_value
+}`, backend, role)
+}
@@ -0,0 +1,155 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var awsAuthBackendTidyBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/tidy$")
+
+func awsAuthBackendTidyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsAuthBackendTidyCreate,
+		Read:   awsAuthBackendTidyRead,
+		Update: awsAuthBackendTidyUpdate,
+		Delete: awsAuthBackendTidyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to tidy.",
+				ForceNew:    true,
+				Default:     "aws",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"identity_accesslist": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to tidy expired entries from the identity access list.",
+			},
+			"roletag_denylist": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to tidy expired entries from the role tag deny list.",
+			},
+			"safety_buffer": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The amount of extra time, in seconds, that must have passed beyond " +
+					"an entry's expiration before it is removed. Vault defaults to 72 hours.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Arbitrary value that causes the tidy operations to run again " +
+					"whenever it changes.",
+			},
+		},
+	}
+}
+
+func awsAuthBackendTidyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	if err := awsAuthBackendTidy(d, client); err != nil {
+		return err
+	}
+
+	d.SetId(awsAuthBackendTidyPath(backend))
+
+	return awsAuthBackendTidyRead(d, meta)
+}
+
+func awsAuthBackendTidyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// Only a new trigger value runs tidy again, other changes apply to the
+	// next run.
+	if d.HasChange("trigger") {
+		if err := awsAuthBackendTidy(d, client); err != nil {
+			return err
+		}
+	}
+
+	return awsAuthBackendTidyRead(d, meta)
+}
+
+func awsAuthBackendTidyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := awsAuthBackendTidyBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return fmt.Errorf("invalid path %q for AWS auth backend tidy", path)
+	}
+	backend := res[1]
+
+	log.Printf("[DEBUG] Reading auth methods to find AWS auth backend %q", backend)
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading auth methods: %s", err)
+	}
+	log.Printf("[DEBUG] Read auth methods to find AWS auth backend %q", backend)
+
+	if _, ok := auths[backend+"/"]; !ok {
+		log.Printf("[WARN] AWS auth backend %q not found, removing tidy from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+
+	return nil
+}
+
+func awsAuthBackendTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	// Tidy operations cannot be undone, so there is nothing to delete.
+	return nil
+}
+
+func awsAuthBackendTidy(d *schema.ResourceData, client *api.Client) error {
+	backend := d.Get("backend").(string)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("safety_buffer"); ok {
+		data["safety_buffer"] = v
+	}
+
+	var ops []string
+	if d.Get("identity_accesslist").(bool) {
+		ops = append(ops, "identity-accesslist")
+	}
+	if d.Get("roletag_denylist").(bool) {
+		ops = append(ops, "roletag-denylist")
+	}
+
+	for _, op := range ops {
+		path := awsAuthBackendTidyPath(backend) + "/" + op
+
+		log.Printf("[DEBUG] Tidying AWS auth backend %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error tidying AWS auth backend %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Tidied AWS auth backend %q", path)
+	}
+
+	return nil
+}
+
+func awsAuthBackendTidyPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/tidy"
+}
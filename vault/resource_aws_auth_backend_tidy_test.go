@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAWSAuthBackendTidy_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resourceName := "vault_aws_auth_backend_tidy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendTidyConfig(backend, "one", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/tidy"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "identity_accesslist", "true"),
+					resource.TestCheckResourceAttr(resourceName, "roletag_denylist", "true"),
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "3600"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendTidyConfig(backend, "two", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "trigger", "two"),
+					resource.TestCheckResourceAttr(resourceName, "roletag_denylist", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAWSAuthBackendTidyConfig(backend, trigger string, roletagDenylist bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  type = "aws"
+  path = "%s"
+}
+
+resource "vault_aws_auth_backend_tidy" "test" {
+  backend          = vault_auth_backend.aws.path
+  safety_buffer    = 3600
+  roletag_denylist = %t
+  trigger          = "%s"
+}
+`, backend, roletagDenylist, trigger)
+}
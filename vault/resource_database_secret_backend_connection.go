@@ -20,7 +20,7 @@ type connectionStringConfig struct {
 var (
 	databaseSecretBackendConnectionBackendFromPathRegex = regexp.MustCompile("^(.+)/config/.+$")
 	databaseSecretBackendConnectionNameFromPathRegex    = regexp.MustCompile("^.+/config/(.+$)")
-	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch", "snowflake"}
+	dbBackendTypes                                      = []string{"cassandra", "hana", "mongodb", "mongodbatlas", "mssql", "mysql", "mysql_rds", "mysql_aurora", "mysql_legacy", "postgresql", "oracle", "elasticsearch", "snowflake"}
 )
 
 func databaseSecretBackendConnectionResource() *schema.Resource {
@@ -69,6 +69,7 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				Description: "A map of sensitive data to pass to the endpoint. Useful for templated connection strings.",
 				Sensitive:   true,
 			},
+			"password_version": writeOnlyVersionSchema("the connection password"),
 
 			"elasticsearch": {
 				Type:        schema.TypeList,
@@ -433,9 +434,7 @@ func getDatabaseAPIData(d *schema.ResourceData) (map[string]interface{}, error)
 		if v, ok := d.GetOk("cassandra.0.username"); ok {
 			data["username"] = v.(string)
 		}
-		if v, ok := d.GetOk("cassandra.0.password"); ok {
-			data["password"] = v.(string)
-		}
+		setDatabaseConnectionPassword(d, "cassandra.0.", data)
 		if v, ok := d.GetOkExists("cassandra.0.tls"); ok {
 			data["tls"] = v.(bool)
 		}
@@ -663,9 +662,7 @@ func setElasticsearchDatabaseConnectionData(d *schema.ResourceData, prefix strin
 		data["username"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 }
 
 func setSnowflakeDatabaseConnectionData(d *schema.ResourceData, prefix string, data map[string]interface{}) {
@@ -674,15 +671,22 @@ func setSnowflakeDatabaseConnectionData(d *schema.ResourceData, prefix string, d
 		data["username"] = v.(string)
 	}
 
-	if v, ok := d.GetOk(prefix + "password"); ok {
-		data["password"] = v.(string)
-	}
+	setDatabaseConnectionPassword(d, prefix, data)
 
 	if v, ok := d.GetOk(prefix + "username_template"); ok {
 		data["username_template"] = v.(string)
 	}
 }
 
+// setDatabaseConnectionPassword adds the password of the plugin block at
+// prefix. Vault does not return it, and it may have been rotated since, so it
+// is only sent when it is written for the first time or again.
+func setDatabaseConnectionPassword(d *schema.ResourceData, prefix string, data map[string]interface{}) {
+	if v, ok := d.GetOk(prefix + "password"); ok && writeOnlyChanged(d, prefix+"password", "password_version") {
+		data["password"] = v.(string)
+	}
+}
+
 func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -828,8 +832,12 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 			if v, ok := data["public_key"]; ok {
 				result["public_key"] = v.(string)
 			}
+			// Vault does not return the private key, so it is kept from
+			// the configuration.
 			if v, ok := data["private_key"]; ok {
 				result["private_key"] = v.(string)
+			} else {
+				result["private_key"] = d.Get("mongodbatlas.0.private_key").(string)
 			}
 			if v, ok := data["project_id"]; ok {
 				result["project_id"] = v.(string)
@@ -910,7 +918,7 @@ func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interfac
 			// Vault does not return the password in the API. If the root credentials have been rotated, sending
 			// the old password in the update request would break the connection config. Thus we only send it,
 			// if it actually changed, to still support updating it for non-rotated cases.
-			if k == "password" && d.HasChange(k) {
+			if k == "password" && writeOnlyChanged(d, "data.password", "password_version") {
 				data[k] = v.(string)
 			}
 		}
@@ -924,6 +932,17 @@ func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interfac
 	}
 	log.Printf("[DEBUG] Wrote database connection config %q", path)
 
+	// The mongodbatlas plugin keeps using its existing API client until the
+	// connection is reset, so new credentials only take effect after that.
+	if d.HasChange("mongodbatlas") && len(d.Get("mongodbatlas").([]interface{})) > 0 {
+		resetPath := databaseSecretBackendConnectionResetPath(backend, name)
+		log.Printf("[DEBUG] Resetting database connection %q", resetPath)
+		if _, err := client.Logical().Write(resetPath, nil); err != nil {
+			return fmt.Errorf("error resetting database connection %q: %s", resetPath, err)
+		}
+		log.Printf("[DEBUG] Reset database connection %q", resetPath)
+	}
+
 	return databaseSecretBackendConnectionRead(d, meta)
 }
 
@@ -959,6 +978,10 @@ func databaseSecretBackendConnectionPath(backend, name string) string {
 	return strings.Trim(backend, "/") + "/config/" + strings.Trim(name, "/")
 }
 
+func databaseSecretBackendConnectionResetPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/reset/" + strings.Trim(name, "/")
+}
+
 func databaseSecretBackendConnectionNameFromPath(path string) (string, error) {
 	if !databaseSecretBackendConnectionNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
@@ -1,10 +1,14 @@
 package vault
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -14,6 +18,74 @@ import (
 	"github.com/hashicorp/vault/sdk/database/helper/dbutil"
 )
 
+func TestDatabaseSecretBackendConnectionPasswordVersion(t *testing.T) {
+	var written map[string]interface{}
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut || r.Method == http.MethodPost {
+			written = nil
+			if err := json.NewDecoder(r.Body).Decode(&written); err != nil {
+				t.Error(err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"plugin_name":        "cassandra-database-plugin",
+				"connection_details": map[string]interface{}{"hosts": "db1", "username": "vault"},
+				"allowed_roles":      strings.Split(written["allowed_roles"].(string), ","),
+			},
+		})
+	})
+
+	r := databaseSecretBackendConnectionResource()
+	rawConfig := func(version string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"backend":          "database",
+			"name":             "cassandra",
+			"password_version": version,
+			"allowed_roles":    []interface{}{"dev"},
+			"cassandra": []interface{}{
+				map[string]interface{}{
+					"hosts":    []interface{}{"db1"},
+					"username": "vault",
+					"password": "secret",
+				},
+			},
+		})
+	}
+	apply := func(state *terraform.InstanceState, c *terraform.ResourceConfig) *terraform.InstanceState {
+		diff, err := r.Diff(context.Background(), state, c, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		state, diags := r.Apply(context.Background(), state, diff, client)
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		return state
+	}
+
+	state := apply(nil, rawConfig("1"))
+	if written["password"] != "secret" {
+		t.Fatalf("expected the password to be written on create, got %v", written)
+	}
+
+	c := rawConfig("1")
+	c.Config["allowed_roles"] = []interface{}{"dev", "prod"}
+	c.Raw["allowed_roles"] = c.Config["allowed_roles"]
+	state = apply(state, c)
+	if _, ok := written["password"]; ok {
+		t.Fatalf("expected the unchanged password not to be written, got %v", written)
+	}
+
+	apply(state, rawConfig("2"))
+	if written["password"] != "secret" {
+		t.Fatalf("expected the password to be written when password_version changes, got %v", written)
+	}
+}
+
 func TestAccDatabaseSecretBackendConnection_import(t *testing.T) {
 	connURL := os.Getenv("POSTGRES_URL")
 	if connURL == "" {
@@ -172,6 +244,12 @@ func TestAccDatabaseSecretBackendConnection_mongodbatlas(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_connection.test", "mongodbatlas.0.project_id", project_id),
 				),
 			},
+			{
+				ResourceName:            "vault_database_secret_backend_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_connection", "mongodbatlas.0.private_key"},
+			},
 		},
 	})
 }
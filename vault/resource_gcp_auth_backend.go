@@ -121,6 +121,7 @@ func gcpAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	local := d.Get("local").(bool)
 
 	log.Printf("[DEBUG] Enabling gcp auth backend %q", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
 		Type:        authType,
 		Description: desc,
@@ -202,6 +203,7 @@ func gcpAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Deleting gcp auth backend %q", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().DisableAuth(path)
 	if err != nil {
 		return fmt.Errorf("error deleting gcp auth backend %q: %q", path, err)
@@ -298,7 +298,9 @@ func gcpAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("role", role)
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	// Check if the user is using the deprecated `policies`
 	if _, deprecated := d.GetOk("policies"); deprecated {
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -66,10 +67,44 @@ func genericSecretResource() *schema.Resource {
 				Description: "Map of strings read from Vault.",
 				Sensitive:   true,
 			},
+
+			"kv_version": kvVersionSchema(),
+
+			"wrap_ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Response-wrap the write with this TTL, storing the wrapping token instead of the secret's data.",
+				ValidateFunc: validateDuration,
+			},
+
+			"wrapping_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The token wrapping the response of the last write.",
+				Sensitive:   true,
+			},
+
+			"wrapping_accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the token wrapping the response of the last write.",
+			},
 		},
 	}
 }
 
+// kvVersionSchema returns the schema of the kv_version override of the
+// generic secret resources.
+func kvVersionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      kvVersionAuto,
+		Description:  "KV version of the path, detected from its mount if 0. If set to 1 the path is used as is, if set to 2 the data/ segment is always added.",
+		ValidateFunc: validation.IntBetween(0, 2),
+	}
+}
+
 func ValidateDataJSON(configI interface{}, k string) ([]string, []error) {
 	dataJSON := configI.(string)
 	dataMap := map[string]interface{}{}
@@ -110,15 +145,51 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
 	}
 
+	wrapTTL, wrapped := d.GetOk("wrap_ttl")
+	if wrapped {
+		token := client.Token()
+		if client, err = client.Clone(); err != nil {
+			return fmt.Errorf("error cloning client: %s", err)
+		}
+		client.SetToken(token)
+		client.SetWrappingLookupFunc(func(_, _ string) string {
+			return wrapTTL.(string)
+		})
+	}
+
 	path := d.Get("path").(string)
-	originalPath := path // if the path belongs to a v2 endpoint, it will be modified
-	mountPath, v2, err := isKVv2(path, client)
+	resp, err := genericSecretWrite(client, path, data, d.Get("kv_version").(int))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(path)
+
+	if wrapped {
+		// KV-V1 writes have no response, so there is nothing to wrap.
+		if resp == nil || resp.WrapInfo == nil {
+			log.Printf("[WARN] Write to %q returned no response to wrap", path)
+			d.Set("wrapping_token", "")
+			d.Set("wrapping_accessor", "")
+		} else {
+			d.Set("wrapping_token", resp.WrapInfo.Token)
+			d.Set("wrapping_accessor", resp.WrapInfo.Accessor)
+		}
+	}
+
+	return genericSecretResourceRead(d, meta)
+}
+
+// genericSecretWrite writes data to path, wrapping it as required when the
+// path belongs to a KV-V2 engine, and returns the response of the write. See
+// kvAPIPath for kvVersion.
+func genericSecretWrite(client *api.Client, path string, data map[string]interface{}, kvVersion int) (*api.Secret, error) {
+	path, v2, err := kvAPIPath(client, path, "data", kvVersion)
 	if err != nil {
-		return fmt.Errorf("error determining if it's a v2 path: %s", err)
+		return nil, err
 	}
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
 		data = map[string]interface{}{
 			"data":    data,
 			"options": map[string]interface{}{},
@@ -127,28 +198,25 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Writing generic Vault secret to %s", path)
-	_, err = client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
-		return fmt.Errorf("error writing to Vault: %s", err)
+		return nil, fmt.Errorf("error writing to Vault: %s", err)
 	}
 
-	d.SetId(originalPath)
-
-	return genericSecretResourceRead(d, meta)
+	return resp, nil
 }
 
 func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
-	path := d.Id()
+	return genericSecretDelete(client, d.Id(), d.Get("kv_version").(int))
+}
 
-	mountPath, v2, err := isKVv2(path, client)
+// genericSecretDelete deletes the latest version of the secret at path.
+func genericSecretDelete(client *api.Client, path string, kvVersion int) error {
+	path, _, err := kvAPIPath(client, path, "data", kvVersion)
 	if err != nil {
-		return fmt.Errorf("error determining if it's a v2 path: %s", err)
-	}
-
-	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
+		return err
 	}
 
 	log.Printf("[DEBUG] Deleting vault_generic_secret from %q", path)
@@ -171,11 +239,18 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Id()
 
+	// The data of response-wrapped writes is neither read back nor exposed.
+	if _, ok := d.GetOk("wrap_ttl"); ok {
+		log.Printf("[DEBUG] Not reading %s from Vault since its writes are wrapped", path)
+		d.Set("data", map[string]string{})
+		return nil
+	}
+
 	if shouldRead {
 		client := meta.(*api.Client)
 
 		log.Printf("[DEBUG] Reading %s from Vault", path)
-		secret, err := versionedSecret(latestSecretVersion, path, client)
+		secret, err := versionedSecret(latestSecretVersion, path, client, d.Get("kv_version").(int))
 
 		if err != nil {
 			return fmt.Errorf("error reading from Vault: %s", err)
@@ -53,6 +53,106 @@ func TestResourceGenericSecret_deleted(t *testing.T) {
 	})
 }
 
+func TestResourceGenericSecret_kvVersions(t *testing.T) {
+	mountV1 := acctest.RandomWithPrefix("tf-test-kv-v1")
+	mountV2 := acctest.RandomWithPrefix("tf-test-kv-v2")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_kvVersionsConfig(mountV1, mountV2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.v1", "data.zip", "zap"),
+					resource.TestCheckResourceAttr("vault_generic_secret.v2", "data.zip", "zap"),
+					// The data/ segment is stripped, so both paths address
+					// the same KV-V2 secret.
+					resource.TestCheckResourceAttr("vault_generic_secret.v2_data", "data.foo", "bar"),
+					resource.TestCheckResourceAttr("data.vault_generic_secret.v2_data", "data.foo", "bar"),
+					resource.TestCheckResourceAttr("data.vault_generic_secret.v1", "data.zip", "zap"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_wrapTTL(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-test-kv-v2")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_mount" "v2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path      = "${vault_mount.v2.path}/foo"
+  data_json = jsonencode({ zip = "zap" })
+  wrap_ttl  = "5m"
+}
+`, mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vault_generic_secret.test", "wrapping_token"),
+					resource.TestCheckResourceAttrSet("vault_generic_secret.test", "wrapping_accessor"),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_kvVersionsConfig(mountV1, mountV2 string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v1" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_mount" "v2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "v1" {
+  path      = "${vault_mount.v1.path}/foo"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "v2" {
+  path      = "${vault_mount.v2.path}/foo"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "v2_data" {
+  path      = "${vault_mount.v2.path}/data/bar"
+  data_json = jsonencode({ foo = "bar" })
+}
+
+data "vault_generic_secret" "v2_data" {
+  path = "${vault_mount.v2.path}/bar"
+
+  depends_on = [vault_generic_secret.v2_data]
+}
+
+data "vault_generic_secret" "v1" {
+  path = vault_generic_secret.v1.path
+}
+`, mountV1, mountV2)
+}
+
 func testResourceGenericSecret_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "v1" {
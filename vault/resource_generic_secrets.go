@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func genericSecretsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: genericSecretsResourceWrite,
+		UpdateContext: genericSecretsResourceWrite,
+		Delete:        genericSecretsResourceDelete,
+		Read:          genericSecretsResourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"secrets": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Description: "Map of full secret paths to the JSON-encoded data to write at each path. " +
+					"Removing a path from the map deletes the secret.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateFunc:     validateGenericSecretsJSON,
+				DiffSuppressFunc: genericSecretsDiffSuppress,
+				Sensitive:        true,
+			},
+		},
+	}
+}
+
+func validateGenericSecretsJSON(i interface{}, k string) ([]string, []error) {
+	var errs []error
+	for path, v := range i.(map[string]interface{}) {
+		dataJSON, ok := v.(string)
+		if !ok {
+			// Unknown values are validated once they are known.
+			continue
+		}
+		if _, es := ValidateDataJSON(dataJSON, k); len(es) > 0 {
+			errs = append(errs, fmt.Errorf("invalid JSON data for %q in %s: %s", path, k, es[0]))
+		}
+	}
+	return nil, errs
+}
+
+// genericSecretsDiffSuppress ignores formatting differences in the JSON data of
+// a path, without hiding paths being added to or removed from the map.
+func genericSecretsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") || old == "" || new == "" {
+		return false
+	}
+	return util.JsonDiffSuppress(k, old, new, d)
+}
+
+// genericSecretsResourceWrite writes the changed paths. Paths that fail are
+// reported as a warning rather than an error: an error would taint the
+// resource and make the next apply recreate every path, while leaving them
+// out of state only retries them.
+func genericSecretsResourceWrite(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	o, n := d.GetChange("secrets")
+	oldSecrets := o.(map[string]interface{})
+	newSecrets := n.(map[string]interface{})
+
+	// applied tracks what is actually in Vault, so that a partial failure
+	// leaves an accurate record of which paths succeeded.
+	applied := make(map[string]interface{}, len(oldSecrets))
+	for path, v := range oldSecrets {
+		applied[path] = v
+	}
+
+	var succeeded, failed []string
+	for _, path := range genericSecretsSortedPaths(oldSecrets) {
+		if _, ok := newSecrets[path]; ok {
+			continue
+		}
+		if err := genericSecretDelete(client, path, kvVersionAuto); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", path, err))
+			continue
+		}
+		delete(applied, path)
+		succeeded = append(succeeded, path)
+	}
+
+	for _, path := range genericSecretsSortedPaths(newSecrets) {
+		dataJSON := newSecrets[path].(string)
+		if oldJSON, ok := oldSecrets[path]; ok && !d.IsNewResource() && NormalizeDataJSON(oldJSON) == NormalizeDataJSON(dataJSON) {
+			continue
+		}
+
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(dataJSON), &data); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: data syntax error: %s", path, err))
+			continue
+		}
+		if _, err := genericSecretWrite(client, path, data, kvVersionAuto); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %s", path, err))
+			continue
+		}
+		applied[path] = dataJSON
+		succeeded = append(succeeded, path)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(resource.UniqueId())
+	}
+	if err := d.Set("secrets", applied); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := genericSecretsResourceRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if len(failed) > 0 {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "Some generic secrets could not be written",
+				Detail: fmt.Sprintf("Succeeded for %v, failed for:\n%s\n\nThe failed paths are retried on the next apply.",
+					succeeded, strings.Join(failed, "\n")),
+			},
+		}
+	}
+
+	return nil
+}
+
+func genericSecretsResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	secrets := d.Get("secrets").(map[string]interface{})
+
+	remaining := make(map[string]interface{})
+	var failed []string
+	for _, path := range genericSecretsSortedPaths(secrets) {
+		if err := genericSecretDelete(client, path, kvVersionAuto); err != nil {
+			remaining[path] = secrets[path]
+			failed = append(failed, fmt.Sprintf("%s: %s", path, err))
+		}
+	}
+
+	if len(failed) > 0 {
+		d.Set("secrets", remaining)
+		return fmt.Errorf("error deleting generic secrets:\n%s", strings.Join(failed, "\n"))
+	}
+
+	return nil
+}
+
+func genericSecretsResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	secrets := d.Get("secrets").(map[string]interface{})
+
+	result := make(map[string]interface{}, len(secrets))
+	for _, path := range genericSecretsSortedPaths(secrets) {
+		log.Printf("[DEBUG] Reading %s from Vault", path)
+		secret, err := versionedSecret(latestSecretVersion, path, client, kvVersionAuto)
+		if err != nil {
+			return fmt.Errorf("error reading %q from Vault: %s", path, err)
+		}
+		if secret == nil {
+			// Dropping the path makes Terraform plan to write it again.
+			log.Printf("[WARN] secret (%s) not found, removing it from state", path)
+			continue
+		}
+
+		jsonData, err := json.Marshal(secret.Data)
+		if err != nil {
+			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+		}
+		result[path] = string(jsonData)
+	}
+
+	if err := d.Set("secrets", result); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func genericSecretsSortedPaths(secrets map[string]interface{}) []string {
+	paths := make([]string, 0, len(secrets))
+	for path := range secrets {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
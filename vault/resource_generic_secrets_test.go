@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceGenericSecrets(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secrets")
+	resourceName := "vault_generic_secrets.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceGenericSecretsCheckDestroy(mount, "a", "b", "c"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecretsConfig(mount, map[string]string{
+					"a": `{"zip": "zap"}`,
+					"b": `{"foo": "bar"}`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "2"),
+					testResourceGenericSecretsCheckPath(mount+"/a", "zip", "zap"),
+					testResourceGenericSecretsCheckPath(mount+"/b", "foo", "bar"),
+				),
+			},
+			{
+				// Removing "a" deletes it, while "b" changes and "c" is added.
+				Config: testResourceGenericSecretsConfig(mount, map[string]string{
+					"b": `{"foo": "baz"}`,
+					"c": `{"one": "two"}`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secrets.%", "2"),
+					testResourceGenericSecretsCheckDestroy(mount, "a"),
+					testResourceGenericSecretsCheckPath(mount+"/b", "foo", "baz"),
+					testResourceGenericSecretsCheckPath(mount+"/c", "one", "two"),
+				),
+			},
+			{
+				// Deleting a path outside of Terraform causes it to be written again.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if _, err := client.Logical().Delete(mount + "/c"); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testResourceGenericSecretsConfig(mount, map[string]string{
+					"b": `{"foo": "baz"}`,
+					"c": `{"one": "two"}`,
+				}),
+				Check: testResourceGenericSecretsCheckPath(mount+"/c", "one", "two"),
+			},
+		},
+	})
+}
+
+func TestGenericSecretsResourceWrite_partialFailure(t *testing.T) {
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/v1/secret/ok" && r.Method == http.MethodPut:
+			w.WriteHeader(http.StatusNoContent)
+		case r.URL.Path == "/v1/secret/ok" && r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"data":{"zip":"zap"}}`)
+		case r.URL.Path == "/v1/secret/denied":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors":["permission denied"]}`)
+		default:
+			// Includes the KV version lookup, which then assumes v1.
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, genericSecretsResource().Schema, map[string]interface{}{
+		"secrets": map[string]interface{}{
+			"secret/ok":     `{"zip":"zap"}`,
+			"secret/denied": `{"zip":"zap"}`,
+		},
+	})
+	d.MarkNewResource()
+
+	diags := genericSecretsResourceWrite(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatalf("expected no error, which would taint the resource, got %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Error("expected the resource to be saved")
+	}
+	secrets := d.Get("secrets").(map[string]interface{})
+	if len(secrets) != 1 || secrets["secret/ok"] == nil {
+		t.Errorf("expected only secret/ok in state, got %v", secrets)
+	}
+}
+
+func TestGenericSecretsDiffSuppress(t *testing.T) {
+	tests := []struct {
+		name string
+		k    string
+		old  string
+		new  string
+		want bool
+	}{
+		{"same", "secrets.a", `{"zip":"zap"}`, `{ "zip": "zap" }`, true},
+		{"changed", "secrets.a", `{"zip":"zap"}`, `{"zip":"zop"}`, false},
+		{"added", "secrets.a", "", `{"zip":"zap"}`, false},
+		{"removed", "secrets.a", `{"zip":"zap"}`, "", false},
+		{"count", "secrets.%", "1", "1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := genericSecretsDiffSuppress(tt.k, tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("genericSecretsDiffSuppress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateGenericSecretsJSON(t *testing.T) {
+	if _, errs := validateGenericSecretsJSON(map[string]interface{}{"a": `{"zip":"zap"}`}, "secrets"); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+	if _, errs := validateGenericSecretsJSON(map[string]interface{}{"a": `{"zip":`}, "secrets"); len(errs) != 1 {
+		t.Errorf("expected one error, got %v", errs)
+	}
+}
+
+func testResourceGenericSecretsCheckPath(path, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		secret, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading back secret %q: %s", path, err)
+		}
+		if secret == nil {
+			return fmt.Errorf("secret %q not found", path)
+		}
+		if got := secret.Data[key]; got != value {
+			return fmt.Errorf("%q[%q] contains %v; want %q", path, key, got, value)
+		}
+		return nil
+	}
+}
+
+func testResourceGenericSecretsCheckDestroy(mount string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, name := range names {
+			path := mount + "/" + name
+			secret, err := client.Logical().Read(path)
+			if err != nil {
+				// The mount itself may already have been removed.
+				continue
+			}
+			if secret != nil {
+				return fmt.Errorf("secret %q still exists", path)
+			}
+		}
+		return nil
+	}
+}
+
+func testResourceGenericSecretsConfig(mount string, secrets map[string]string) string {
+	var entries string
+	for name, data := range secrets {
+		entries += fmt.Sprintf("    \"${vault_mount.v1.path}/%s\" = %q\n", name, data)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "v1" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_generic_secrets" "test" {
+  secrets = {
+%s  }
+}`, mount, entries)
+}
@@ -89,6 +89,7 @@ func githubAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Enabling github auth backend at '%s'", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
 		Type:        "github",
 		Description: description,
@@ -222,7 +223,9 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	ttlS := flattenVaultDuration(dt.Data["ttl"])
 	maxTtlS := flattenVaultDuration(dt.Data["max_ttl"])
 
-	readTokenFields(d, dt)
+	if err := readTokenFields(d, dt); err != nil {
+		return err
+	}
 
 	// Check if the user is using the deprecated `ttl`
 	if _, deprecated := d.GetOk("ttl"); deprecated {
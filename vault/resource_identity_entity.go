@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -19,7 +20,7 @@ func identityEntityResource() *schema.Resource {
 		Delete: identityEntityDelete,
 		Exists: identityEntityExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: identityEntityImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,6 +64,13 @@ func identityEntityResource() *schema.Resource {
 				Optional:    true,
 				Description: "Whether the entity is disabled. Disabled entities' associated tokens cannot be used, but are not revoked.",
 			},
+
+			"skip_create_if_exists": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Adopt an existing entity with the same name instead of failing on create.",
+			},
 		},
 	}
 }
@@ -124,9 +132,24 @@ func identityEntityCreate(d *schema.ResourceData, meta interface{}) error {
 
 	if resp == nil {
 		path := identityEntityNamePath(name)
-		entityMsg := "Unable to determine entity id."
 
-		if entity, err := client.Logical().Read(path); err == nil {
+		// Vault updates an existing entity with the same name in place and
+		// returns no response, so it only needs to be looked up to adopt it.
+		entity, err := client.Logical().Read(path)
+		if d.Get("skip_create_if_exists").(bool) {
+			if err != nil {
+				return fmt.Errorf("error reading existing IdentityEntity %q: %s", name, err)
+			}
+			if entity == nil {
+				return fmt.Errorf("existing IdentityEntity %q not found", name)
+			}
+			log.Printf("[DEBUG] Adopted existing IdentityEntity %q", name)
+			d.SetId(entity.Data["id"].(string))
+			return identityEntityRead(d, meta)
+		}
+
+		entityMsg := "Unable to determine entity id."
+		if err == nil && entity != nil {
 			entityMsg = fmt.Sprintf("Entity resource ID %q may be imported.", entity.Data["id"])
 		}
 
@@ -233,6 +256,35 @@ func identityEntityExists(d *schema.ResourceData, meta interface{}) (bool, error
 	return resp != nil, nil
 }
 
+// identityEntityImport imports an entity by its ID, or by its name when the
+// import ID has the form "name/<name>".
+func identityEntityImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("skip_create_if_exists", false); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimPrefix(d.Id(), "name/")
+	if name == d.Id() {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	client := meta.(*api.Client)
+	path := identityEntityNamePath(name)
+
+	log.Printf("[DEBUG] Looking up IdentityEntity %q by name", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return nil, fmt.Errorf("error looking up IdentityEntity %q by name: %s", name, err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("no IdentityEntity found with name %q", name)
+	}
+	log.Printf("[DEBUG] Looked up IdentityEntity %q by name", name)
+
+	d.SetId(resp.Data["id"].(string))
+	return []*schema.ResourceData{d}, nil
+}
+
 func identityEntityNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", identityEntityPath, name)
 }
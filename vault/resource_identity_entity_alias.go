@@ -1,15 +1,25 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
 const identityEntityAliasPath = "/identity/entity-alias"
 
+// identityAliasRetryTimeout is how long alias creation is retried while Vault
+// reports storage contention.
+var identityAliasRetryTimeout = 30 * time.Second
+
 func identityEntityAliasResource() *schema.Resource {
 	return &schema.Resource{
 		Create: identityEntityAliasCreate,
@@ -18,8 +28,9 @@ func identityEntityAliasResource() *schema.Resource {
 		Delete: identityEntityAliasDelete,
 		Exists: identityEntityAliasExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: identityEntityAliasImport,
 		},
+		CustomizeDiff: identityEntityAliasCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -29,9 +40,18 @@ func identityEntityAliasResource() *schema.Resource {
 			},
 
 			"mount_accessor": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Mount accessor to which this alias belongs toMount accessor to which this alias belongs to.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Mount accessor to which this alias belongs to.",
+				AtLeastOneOf: []string{"mount_accessor", "mount_path"},
+			},
+
+			"mount_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Path of the auth mount to which this alias belongs to, resolved to its mount accessor.",
+				AtLeastOneOf: []string{"mount_accessor", "mount_path"},
 			},
 
 			"canonical_id": {
@@ -47,9 +67,13 @@ func identityEntityAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	name := d.Get("name").(string)
-	mountAccessor := d.Get("mount_accessor").(string)
 	canonicalID := d.Get("canonical_id").(string)
 
+	mountAccessor, err := identityEntityAliasMountAccessor(d, client)
+	if err != nil {
+		return err
+	}
+
 	path := identityEntityAliasPath
 
 	data := map[string]interface{}{
@@ -58,7 +82,7 @@ func identityEntityAliasCreate(d *schema.ResourceData, meta interface{}) error {
 		"canonical_id":   canonicalID,
 	}
 
-	resp, err := client.Logical().Write(path, data)
+	resp, err := identityAliasWrite(client, path, data)
 
 	if err != nil {
 		return fmt.Errorf("error writing IdentityEntityAlias to %q: %s", name, err)
@@ -102,7 +126,11 @@ func identityEntityAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	if name, ok := d.GetOk("name"); ok {
 		data["name"] = name
 	}
-	if mountAccessor, ok := d.GetOk("mount_accessor"); ok {
+	mountAccessor, err := identityEntityAliasMountAccessor(d, client)
+	if err != nil {
+		return err
+	}
+	if mountAccessor != "" {
 		data["mount_accessor"] = mountAccessor
 	}
 	if canonicalID, ok := d.GetOk("canonical_id"); ok {
@@ -185,6 +213,63 @@ func identityEntityAliasExists(d *schema.ResourceData, meta interface{}) (bool,
 	return resp != nil, nil
 }
 
+// identityEntityAliasImport accepts either the ID of the alias or
+// "<mount_accessor>/<alias_name>". Alias names are only unique per mount, so
+// the accessor is required to import an alias by name.
+func identityEntityAliasImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) == 1 {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	mountAccessor, name := parts[0], parts[1]
+	if mountAccessor == "" || name == "" {
+		return nil, fmt.Errorf("invalid import ID %q, expected an alias ID or <mount_accessor>/<alias_name>", d.Id())
+	}
+
+	id, err := findAliasIDByMountAccessor(meta.(*api.Client), mountAccessor, name)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// identityEntityAliasCustomizeDiff recomputes mount_accessor when the alias
+// is moved to another mount_path without an accessor being configured.
+func identityEntityAliasCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("mount_path") || d.HasChange("mount_accessor") {
+		return nil
+	}
+	if d.Get("mount_path").(string) == "" {
+		return nil
+	}
+	return d.SetNewComputed("mount_accessor")
+}
+
+// identityEntityAliasMountAccessor returns the mount accessor of the alias,
+// resolving mount_path if set. When both mount_accessor and mount_path are
+// set they must refer to the same auth mount.
+func identityEntityAliasMountAccessor(d *schema.ResourceData, client *api.Client) (string, error) {
+	mountAccessor := d.Get("mount_accessor").(string)
+
+	mountPath := d.Get("mount_path").(string)
+	if mountPath == "" {
+		return mountAccessor, nil
+	}
+
+	accessor, err := authMountAccessor(client, mountPath)
+	if err != nil {
+		return "", fmt.Errorf("error resolving the accessor of mount_path %q: %s", mountPath, err)
+	}
+	if mountAccessor != "" && mountAccessor != accessor {
+		return "", fmt.Errorf("mount_accessor %q does not match the accessor %q of mount_path %q", mountAccessor, accessor, mountPath)
+	}
+
+	return accessor, nil
+}
+
 func identityEntityAliasNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", identityEntityAliasPath, name)
 }
@@ -193,6 +278,26 @@ func identityEntityAliasIDPath(id string) string {
 	return fmt.Sprintf("%s/id/%s", identityEntityAliasPath, id)
 }
 
+// identityAliasWrite writes an entity or group alias, retrying when Vault
+// responds with a conflict or rate limit error. These are returned when many
+// aliases are created in parallel and are safe to retry.
+func identityAliasWrite(client *api.Client, path string, data map[string]interface{}) (*api.Secret, error) {
+	var resp *api.Secret
+	err := resource.Retry(identityAliasRetryTimeout, func() *resource.RetryError {
+		var err error
+		resp, err = client.Logical().Write(path, data)
+		if err != nil {
+			if util.ErrorContainsHTTPCode(err, http.StatusConflict, http.StatusTooManyRequests) {
+				log.Printf("[DEBUG] Retrying identity alias write to %q: %s", path, err)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return resp, err
+}
+
 func findAliasID(client *api.Client, canonicalID, name, mountAccessor string) (string, error) {
 	path := identityEntityIDPath(canonicalID)
 
@@ -213,3 +318,28 @@ func findAliasID(client *api.Client, canonicalID, name, mountAccessor string) (s
 
 	return "", fmt.Errorf("unable to determine alias ID. canonical ID: %q  name: %q  mountAccessor: %q", canonicalID, name, mountAccessor)
 }
+
+// findAliasIDByMountAccessor lists all entity aliases and returns the ID of
+// the one with the given name under the given mount accessor.
+func findAliasIDByMountAccessor(client *api.Client, mountAccessor, name string) (string, error) {
+	path := identityEntityAliasPath + "/id"
+
+	log.Printf("[DEBUG] Listing IdentityEntityAliases from %q", path)
+	_, keyInfo, err := listKeys(client, path)
+	if err != nil {
+		return "", fmt.Errorf("error listing entity aliases: %s", err)
+	}
+	log.Printf("[DEBUG] Listed IdentityEntityAliases from %q", path)
+
+	for id, infoRaw := range keyInfo {
+		info, ok := infoRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if info["name"] == name && info["mount_accessor"] == mountAccessor {
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no entity alias %q found for mount accessor %q", name, mountAccessor)
+}
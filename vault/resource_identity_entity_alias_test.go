@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
@@ -39,6 +40,49 @@ func TestAccIdentityEntityAlias(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAlias_import(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	nameEntityAlias := "vault_identity_entity_alias.entity-alias"
+	nameGithubA := "vault_auth_backend.githubA"
+
+	importStateIDFunc := func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[nameGithubA]
+		if !ok {
+			return "", fmt.Errorf("resource %q not found in state", nameGithubA)
+		}
+		return rs.Primary.Attributes["accessor"] + "/" + entity, nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityAliasConfig(entity, false, false),
+			},
+			{
+				ResourceName:      nameEntityAlias,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      nameEntityAlias,
+				ImportState:       true,
+				ImportStateIdFunc: importStateIDFunc,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  nameEntityAlias,
+				ImportState:   true,
+				ImportStateId: "auth_github_unknown/" + entity,
+				ExpectError:   regexp.MustCompile(`no entity alias .* found for mount accessor`),
+			},
+		},
+	})
+}
+
 func TestAccIdentityEntityAlias_Update(t *testing.T) {
 	entity := acctest.RandomWithPrefix("my-entity")
 
@@ -73,6 +117,38 @@ func TestAccIdentityEntityAlias_Update(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAlias_mountPath(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	nameEntityAlias := "vault_identity_entity_alias.entity-alias"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityAliasConfigMountPath(entity, "A", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "mount_path", "vault_auth_backend.githubA", "path"),
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "mount_accessor", "vault_auth_backend.githubA", "accessor"),
+				),
+			},
+			{
+				Config: testAccIdentityEntityAliasConfigMountPath(entity, "B", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "mount_path", "vault_auth_backend.githubB", "path"),
+					resource.TestCheckResourceAttrPair(nameEntityAlias, "mount_accessor", "vault_auth_backend.githubB", "accessor"),
+				),
+			},
+			{
+				Config:      testAccIdentityEntityAliasConfigMountPath(entity, "B", "vault_auth_backend.githubA.accessor"),
+				ExpectError: regexp.MustCompile(`mount_accessor ".+" does not match the accessor ".+" of mount_path`),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityEntityAliasDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -139,3 +215,100 @@ resource "vault_identity_entity_alias" "entity-alias-dupe" {
 
 	return ret
 }
+
+func testAccIdentityEntityAliasConfigMountPath(entityName, target, mountAccessor string) string {
+	ret := fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+  policies = ["test"]
+}
+
+resource "vault_auth_backend" "githubA" {
+  type = "github"
+  path = "githubA-%s"
+}
+
+resource "vault_auth_backend" "githubB" {
+  type = "github"
+  path = "githubB-%s"
+}
+
+resource "vault_identity_entity_alias" "entity-alias" {
+  name = vault_identity_entity.entity.name
+  mount_path = vault_auth_backend.github%s.path
+  canonical_id = vault_identity_entity.entity.id
+`, entityName, entityName, entityName, target)
+
+	if mountAccessor != "" {
+		ret += fmt.Sprintf("  mount_accessor = %s\n", mountAccessor)
+	}
+
+	return ret + "}\n"
+}
+
+func TestIdentityAliasWrite_retryOnConflict(t *testing.T) {
+	requests := 0
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if requests == 1 {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"errors":["storage contention"]}`)
+			return
+		}
+		fmt.Fprint(w, `{"data":{"id":"alias-id"}}`)
+	})
+
+	resp, err := identityAliasWrite(client, identityEntityAliasPath, map[string]interface{}{
+		"name": "alias",
+	})
+	if err != nil {
+		t.Fatalf("expected write to succeed after retry, got: %s", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if resp == nil || resp.Data["id"] != "alias-id" {
+		t.Fatalf("unexpected response: %#v", resp)
+	}
+}
+
+func TestFindAliasIDByMountAccessor(t *testing.T) {
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{"keys":["id-a","id-b"],"key_info":{`+
+			`"id-a":{"name":"alice","mount_accessor":"auth_github_a","canonical_id":"entity-a"},`+
+			`"id-b":{"name":"alice","mount_accessor":"auth_github_b","canonical_id":"entity-b"}}}}`)
+	})
+
+	id, err := findAliasIDByMountAccessor(client, "auth_github_b", "alice")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if id != "id-b" {
+		t.Fatalf("expected alias id-b, got %q", id)
+	}
+
+	if _, err := findAliasIDByMountAccessor(client, "auth_github_c", "alice"); err == nil {
+		t.Fatal("expected an error for an unknown mount accessor")
+	}
+}
+
+func TestIdentityAliasWrite_noRetryOnBadRequest(t *testing.T) {
+	requests := 0
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors":["invalid mount accessor"]}`)
+	})
+
+	if _, err := identityAliasWrite(client, identityEntityAliasPath, map[string]interface{}{
+		"name": "alias",
+	}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request, got %d", requests)
+	}
+}
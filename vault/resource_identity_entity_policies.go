@@ -117,7 +117,8 @@ func identityEntityPoliciesRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		userPolicies := d.Get("policies").(*schema.Set).List()
 		newPolicies := make([]string, 0)
-		apiPolicies := resp.Data["policies"].([]interface{})
+		// policies may be absent if they were all removed outside of Terraform
+		apiPolicies, _ := resp.Data["policies"].([]interface{})
 
 		for _, policy := range userPolicies {
 			if found, _ := util.SliceHasElement(apiPolicies, policy); found {
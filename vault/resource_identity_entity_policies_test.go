@@ -69,6 +69,74 @@ func TestAccIdentityEntityPoliciesNonExclusive(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityPoliciesExclusive_removesExternal(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityEntityPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityPoliciesConfigExclusive(entity),
+				Check:  testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"test"}),
+			},
+			{
+				PreConfig: func() {
+					testAccIdentityEntityPoliciesAddExternal(t, entity, "external")
+				},
+				Config: testAccIdentityEntityPoliciesConfigExclusive(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"test"}),
+					resource.TestCheckResourceAttr("vault_identity_entity_policies.policies", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIdentityEntityPoliciesNonExclusive_preservesExternal(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityEntityPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, "dev"),
+				Check:  testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"dev"}),
+			},
+			{
+				PreConfig: func() {
+					testAccIdentityEntityPoliciesAddExternal(t, entity, "external")
+				},
+				Config: testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityPoliciesCheckLogical("vault_identity_entity.entity", []string{"external", "test"}),
+					resource.TestCheckResourceAttr("vault_identity_entity_policies.policies", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_entity_policies.policies", "policies.0", "test"),
+				),
+			},
+		},
+	})
+}
+
+// testAccIdentityEntityPoliciesAddExternal simulates another system adding a
+// policy to the entity outside of Terraform.
+func testAccIdentityEntityPoliciesAddExternal(t *testing.T, entity, policy string) {
+	client := testProvider.Meta().(*api.Client)
+	resp, err := client.Logical().Read("identity/entity/name/" + entity)
+	if err != nil || resp == nil {
+		t.Fatalf("unable to read entity %q: %v", entity, err)
+	}
+	policies, _ := resp.Data["policies"].([]interface{})
+	policies = append(policies, policy)
+	if _, err := client.Logical().Write(identityEntityIDPath(resp.Data["id"].(string)), map[string]interface{}{
+		"policies": policies,
+	}); err != nil {
+		t.Fatalf("unable to add policy %q to entity %q: %s", policy, entity, err)
+	}
+}
+
 func testAccCheckidentityEntityPoliciesDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -322,3 +390,18 @@ resource "vault_identity_entity_policies" "test" {
 }
 `, entity)
 }
+
+func testAccIdentityEntityPoliciesConfigNonExclusiveSingle(entity, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+  external_policies = true
+}
+
+resource "vault_identity_entity_policies" "policies" {
+  entity_id = vault_identity_entity.entity.id
+  exclusive = false
+  policies  = ["%s"]
+}
+`, entity, policy)
+}
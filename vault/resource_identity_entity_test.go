@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -107,6 +108,49 @@ func TestAccIdentityEntityUpdateRemovePolicies(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntity_skipCreateIfExists(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					resp, err := client.Logical().Write(identityEntityPath, map[string]interface{}{
+						"name": entity,
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+					existingID = resp.Data["id"].(string)
+				},
+				Config:      testAccIdentityEntityConfig(entity),
+				ExpectError: regexp.MustCompile(`already exists`),
+			},
+			{
+				Config: testAccIdentityEntityConfigSkipCreateIfExists(entity),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityEntityCheckAttrs(),
+					resource.TestCheckResourceAttrPtr("vault_identity_entity.entity", "id", &existingID),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "metadata.version", "1"),
+				),
+			},
+			{
+				ResourceName:            "vault_identity_entity.entity",
+				ImportState:             true,
+				ImportStateId:           "name/" + entity,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_create_if_exists"},
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityEntityDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -259,3 +303,15 @@ resource "vault_identity_entity" "entity" {
   external_policies = true
 }`, entityName)
 }
+
+func testAccIdentityEntityConfigSkipCreateIfExists(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+  policies = ["test"]
+  metadata = {
+    version = "1"
+  }
+  skip_create_if_exists = true
+}`, entityName)
+}
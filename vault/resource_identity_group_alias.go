@@ -1,8 +1,11 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -10,6 +13,36 @@ import (
 
 const identityGroupAliasPath = "/identity/group-alias"
 
+// identityGroupAliasMountTypes are the built-in auth methods that report
+// external group membership, and so can back a group alias.
+var identityGroupAliasMountTypes = map[string]bool{
+	"github":   true,
+	"jwt":      true,
+	"kerberos": true,
+	"ldap":     true,
+	"oidc":     true,
+	"okta":     true,
+	"saml":     true,
+}
+
+// identityGroupAliasIncompatibleMountTypes are the built-in auth methods that
+// never report external group membership. Other types, e.g. plugins, may do
+// so and are accepted.
+var identityGroupAliasIncompatibleMountTypes = map[string]bool{
+	"alicloud":   true,
+	"approle":    true,
+	"aws":        true,
+	"azure":      true,
+	"cert":       true,
+	"cf":         true,
+	"gcp":        true,
+	"kubernetes": true,
+	"oci":        true,
+	"radius":     true,
+	"token":      true,
+	"userpass":   true,
+}
+
 func identityGroupAliasResource() *schema.Resource {
 	return &schema.Resource{
 		Create: identityGroupAliasCreate,
@@ -20,6 +53,7 @@ func identityGroupAliasResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: identityGroupAliasCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,6 +84,10 @@ func identityGroupAliasCreate(d *schema.ResourceData, meta interface{}) error {
 	mountAccessor := d.Get("mount_accessor").(string)
 	canonicalID := d.Get("canonical_id").(string)
 
+	if err := identityGroupAliasValidateMount(client, mountAccessor); err != nil {
+		return err
+	}
+
 	path := identityGroupAliasPath
 
 	data := map[string]interface{}{
@@ -58,7 +96,7 @@ func identityGroupAliasCreate(d *schema.ResourceData, meta interface{}) error {
 		"canonical_id":   canonicalID,
 	}
 
-	resp, err := client.Logical().Write(path, data)
+	resp, err := identityAliasWrite(client, path, data)
 
 	if err != nil {
 		return fmt.Errorf("error writing IdentityGroupAlias to %q: %s", name, err)
@@ -91,6 +129,11 @@ func identityGroupAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["name"] = name
 	}
 	if mountAccessor, ok := d.GetOk("mount_accessor"); ok {
+		if d.HasChange("mount_accessor") {
+			if err := identityGroupAliasValidateMount(client, mountAccessor.(string)); err != nil {
+				return err
+			}
+		}
 		data["mount_accessor"] = mountAccessor
 	}
 	if canonicalID, ok := d.GetOk("canonical_id"); ok {
@@ -180,3 +223,34 @@ func identityGroupAliasNamePath(name string) string {
 func identityGroupAliasIDPath(id string) string {
 	return fmt.Sprintf("%s/id/%s", identityGroupAliasPath, id)
 }
+
+func identityGroupAliasCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The accessor usually comes from an auth backend created in the same
+	// apply, in which case it is validated on create instead.
+	if !d.NewValueKnown("mount_accessor") || !d.HasChange("mount_accessor") {
+		return nil
+	}
+	return identityGroupAliasValidateMount(meta.(*api.Client), d.Get("mount_accessor").(string))
+}
+
+// identityGroupAliasValidateMount ensures that the accessor does not belong
+// to an auth method that is known not to support external group aliases.
+func identityGroupAliasValidateMount(client *api.Client, accessor string) error {
+	mountType, err := authMountType(client, accessor)
+	if err != nil {
+		return fmt.Errorf("error validating mount_accessor %q: %s", accessor, err)
+	}
+	if identityGroupAliasIncompatibleMountTypes[mountType] {
+		var supported []string
+		for k := range identityGroupAliasMountTypes {
+			supported = append(supported, k)
+		}
+		sort.Strings(supported)
+		return fmt.Errorf("mount_accessor %q belongs to a %q auth method, which does not support "+
+			"external group aliases, expected one of: %s, or a plugin that does", accessor, mountType, strings.Join(supported, ", "))
+	}
+	if !identityGroupAliasMountTypes[mountType] {
+		log.Printf("[WARN] mount_accessor %q belongs to a %q auth method, which may not support external group aliases", accessor, mountType)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -70,6 +72,82 @@ func TestAccIdentityGroupAliasUpdate(t *testing.T) {
 	})
 }
 
+func TestIdentityGroupAliasValidateMount(t *testing.T) {
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data":{`+
+			`"github/":{"type":"github","accessor":"auth_github_1234"},`+
+			`"custom/":{"type":"custom-plugin","accessor":"auth_custom-plugin_5678"},`+
+			`"userpass/":{"type":"userpass","accessor":"auth_userpass_9012"}}}`)
+	})
+	defer authMountAccessorCacheInvalidate(client)
+
+	tests := []struct {
+		accessor    string
+		expectError string
+	}{
+		{"auth_github_1234", ""},
+		{"auth_custom-plugin_5678", ""},
+		{"auth_userpass_9012", `belongs to a "userpass" auth method, which does not support external group aliases`},
+		{"auth_github_0000", `no auth mount found with accessor "auth_github_0000"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accessor, func(t *testing.T) {
+			err := identityGroupAliasValidateMount(client, tt.accessor)
+			if tt.expectError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !regexp.MustCompile(regexp.QuoteMeta(tt.expectError)).MatchString(err.Error()) {
+				t.Fatalf("expected error %q, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+func TestAccIdentityGroupAlias_invalidMount(t *testing.T) {
+	group := acctest.RandomWithPrefix("my-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityGroupAliasConfigMount(group, "userpass", "vault_auth_backend.test.accessor"),
+				ExpectError: regexp.MustCompile(`belongs to a "userpass" auth method, which does not support external group aliases`),
+			},
+			{
+				Config:      testAccIdentityGroupAliasConfigMount(group, "github", `"auth_github_00000000"`),
+				ExpectError: regexp.MustCompile(`no auth mount found with accessor "auth_github_00000000"`),
+			},
+		},
+	})
+}
+
+func testAccIdentityGroupAliasConfigMount(group, mountType, accessor string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "external"
+  policies = ["test"]
+}
+
+resource "vault_auth_backend" "test" {
+  type = "%s"
+  path = "%s-%s"
+}
+
+resource "vault_identity_group_alias" "group-alias" {
+  name = "%s"
+  mount_accessor = %s
+  canonical_id = vault_identity_group.group.id
+}`, group, mountType, mountType, group, group, accessor)
+}
+
 func testAccCheckIdentityGroupAliasDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -7,6 +7,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
@@ -66,6 +67,49 @@ func TestAccIdentityGroupPoliciesNonExclusive(t *testing.T) {
 	})
 }
 
+func TestAccIdentityGroupPoliciesNonExclusive_preservesExternal(t *testing.T) {
+	group := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckidentityGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupPoliciesConfigNonExclusiveNamed(group, "dev"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityGroupPoliciesCheckLogical("vault_identity_group.group", []string{"dev"}),
+					resource.TestCheckResourceAttr("vault_identity_group_policies.policies", "policies.#", "1"),
+				),
+			},
+			{
+				// Simulate a policy being added to the group outside of
+				// Terraform, e.g. by an IdP sync.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					resp, err := client.Logical().Read("identity/group/name/" + group)
+					if err != nil || resp == nil {
+						t.Fatalf("unable to read group %q: %v", group, err)
+					}
+					policies, _ := resp.Data["policies"].([]interface{})
+					policies = append(policies, "external")
+					if _, err := client.Logical().Write(identityGroupIDPath(resp.Data["id"].(string)), map[string]interface{}{
+						"policies": policies,
+					}); err != nil {
+						t.Fatalf("unable to add external policy to group %q: %s", group, err)
+					}
+				},
+				Config: testAccIdentityGroupPoliciesConfigNonExclusiveNamed(group, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityGroupPoliciesCheckLogical("vault_identity_group.group", []string{"external", "test"}),
+					resource.TestCheckResourceAttr("vault_identity_group_policies.policies", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group_policies.policies", "policies.0", "test"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckidentityGroupPoliciesDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -315,3 +359,18 @@ resource "vault_identity_group_policies" "test" {
 }
 `)
 }
+
+func testAccIdentityGroupPoliciesConfigNonExclusiveNamed(group, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name              = "%s"
+  external_policies = true
+}
+
+resource "vault_identity_group_policies" "policies" {
+  group_id  = vault_identity_group.group.id
+  exclusive = false
+  policies  = ["%s"]
+}
+`, group, policy)
+}
@@ -132,5 +132,10 @@ func identityOidcExists(d *schema.ResourceData, meta interface{}) (bool, error)
 	}
 	log.Printf("[DEBUG] Checked if IdentityOidc for %q is set", addr)
 
-	return resp.Data["issuer"].(string) != "", nil
+	if resp == nil {
+		return false, nil
+	}
+	issuer, _ := resp.Data["issuer"].(string)
+
+	return issuer != "", nil
 }
@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcAssignmentPathTemplate = "identity/oidc/assignment/%s"
+
+func identityOidcAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcAssignmentWrite,
+		Update: identityOidcAssignmentWrite,
+		Read:   identityOidcAssignmentRead,
+		Delete: identityOidcAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the assignment.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"entity_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault entity IDs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Description: "A list of Vault group IDs.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+			},
+
+			"validate_members": {
+				Type:        schema.TypeBool,
+				Description: "Check that every entity and group ID exists before writing the assignment.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func identityOidcAssignmentWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcAssignmentPath(name)
+
+	entityIDs := d.Get("entity_ids").(*schema.Set).List()
+	groupIDs := d.Get("group_ids").(*schema.Set).List()
+
+	if d.Get("validate_members").(bool) && (d.IsNewResource() || d.HasChanges("entity_ids", "group_ids")) {
+		if err := identityOidcAssignmentValidateMembers(client, entityIDs, groupIDs); err != nil {
+			return err
+		}
+	}
+
+	data := map[string]interface{}{
+		"entity_ids": entityIDs,
+		"group_ids":  groupIDs,
+	}
+
+	log.Printf("[DEBUG] Writing IdentityOidcAssignment %s to %s", name, path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing IdentityOidcAssignment %s: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityOidcAssignment %s to %s", name, path)
+
+	d.SetId(name)
+
+	return identityOidcAssignmentRead(d, meta)
+}
+
+// identityOidcAssignmentValidateMembers looks up every entity and group ID so
+// that a mistyped ID is reported now rather than when a token is issued.
+func identityOidcAssignmentValidateMembers(client *api.Client, entityIDs, groupIDs []interface{}) error {
+	for _, id := range entityIDs {
+		path := identityEntityIDPath(id.(string))
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error looking up entity %q: %s", id, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("entity %q referenced in entity_ids does not exist", id)
+		}
+	}
+
+	for _, id := range groupIDs {
+		path := identityGroupIDPath(id.(string))
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error looking up group %q: %s", id, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("group %q referenced in group_ids does not exist", id)
+		}
+	}
+
+	return nil
+}
+
+func identityOidcAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Reading IdentityOidcAssignment %s from %s", name, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read IdentityOidcAssignment %s", name)
+	if resp == nil {
+		log.Printf("[WARN] IdentityOidcAssignment %s not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	for _, k := range []string{"entity_ids", "group_ids"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key \"%s\" on IdentityOidcAssignment %q: %s", k, path, err)
+		}
+	}
+	return nil
+}
+
+func identityOidcAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+	path := identityOidcAssignmentPath(name)
+
+	log.Printf("[DEBUG] Deleting IdentityOidcAssignment %q", name)
+	_, err := client.Logical().Delete(path)
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityOidcAssignment %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted IdentityOidcAssignment %q", name)
+
+	return nil
+}
+
+func identityOidcAssignmentPath(name string) string {
+	return fmt.Sprintf(identityOidcAssignmentPathTemplate, name)
+}
@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcAssignment(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-assignment")
+	resourceName := "vault_identity_oidc_assignment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcAssignmentConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validate_members", "false"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcAssignmentConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "validate_members", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"validate_members"},
+			},
+		},
+	})
+}
+
+func TestAccIdentityOidcAssignment_validateMembers(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-assignment")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcAssignmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_oidc_assignment" "test" {
+  name             = "%s"
+  entity_ids       = ["00000000-0000-0000-0000-000000000000"]
+  validate_members = true
+}`, name),
+				ExpectError: regexp.MustCompile(`entity "00000000-0000-0000-0000-000000000000" referenced in entity_ids does not exist`),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityOidcAssignmentDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_oidc_assignment" {
+			continue
+		}
+		resp, err := client.Logical().Read(identityOidcAssignmentPath(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("error checking for IdentityOidcAssignment %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("IdentityOidcAssignment %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityOidcAssignmentConfig(name string, validate bool) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "test" {
+  name = "%s"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%s"
+}
+
+resource "vault_identity_oidc_assignment" "test" {
+  name             = "%s"
+  entity_ids       = [vault_identity_entity.test.id]
+  group_ids        = [vault_identity_group.test.id]
+  validate_members = %t
+}`, name, name, name, validate)
+}
@@ -9,7 +9,10 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-const identityOidcKeyPathTemplate = "identity/oidc/key/%s"
+const (
+	identityOidcKeyPathTemplate       = "identity/oidc/key/%s"
+	identityOidcKeyRotatePathTemplate = "identity/oidc/key/%s/rotate"
+)
 
 var (
 	identityOidcKeyFields = []string{
@@ -67,6 +70,20 @@ func identityOidcKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+
+			"rotate_trigger": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Arbitrary value that, when changed, immediately rotates the signing key. " +
+					"Setting it on creation does not rotate the newly created key.",
+			},
+
+			"rotate_verification_ttl": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Overrides verification_ttl for the key being rotated out when rotate_trigger changes, " +
+					"in number of seconds. If unset, the key's verification_ttl is used.",
+			},
 		},
 	}
 }
@@ -119,9 +136,33 @@ func identityOidcKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("rotate_trigger") {
+		if err := identityOidcKeyRotate(d, client); err != nil {
+			return err
+		}
+	}
+
 	return identityOidcKeyRead(d, meta)
 }
 
+func identityOidcKeyRotate(d *schema.ResourceData, client *api.Client) error {
+	name := d.Id()
+	path := identityOidcKeyRotatePath(name)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("rotate_verification_ttl"); ok {
+		data["verification_ttl"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Rotating IdentityOidcKey %s at %s", name, path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error rotating IdentityOidcKey %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Rotated IdentityOidcKey %s", name)
+
+	return nil
+}
+
 func identityOidcKeyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	name := d.Id()
@@ -183,6 +224,10 @@ func identityOidcKeyPath(name string) string {
 	return fmt.Sprintf(identityOidcKeyPathTemplate, name)
 }
 
+func identityOidcKeyRotatePath(name string) string {
+	return fmt.Sprintf(identityOidcKeyRotatePathTemplate, name)
+}
+
 func identityOidcKeyApiRead(name string, client *api.Client) (map[string]interface{}, error) {
 	path := identityOidcKeyPath(name)
 	resp, err := client.Logical().Read(path)
@@ -47,6 +47,133 @@ func TestAccIdentityOidcKey(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcKeyRotate(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+	publicKeys := &testAccIdentityOidcKeyPublicKeys{}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			publicKeys.init(t)
+		},
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// Newer versions of Vault also publish the next signing key,
+				// so the number of keys published on creation varies.
+				Config: testAccIdentityOidcKeyConfigRotate(key, "initial"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate_trigger", "initial"),
+					publicKeys.check(-1),
+				),
+			},
+			{
+				// Refreshing with an unchanged trigger must not rotate.
+				Config: testAccIdentityOidcKeyConfigRotate(key, "initial"),
+				Check:  publicKeys.check(0),
+			},
+			{
+				Config: testAccIdentityOidcKeyConfigRotate(key, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate_trigger", "rotated"),
+					publicKeys.check(1),
+				),
+			},
+		},
+	})
+}
+
+// testAccIdentityOidcKeyPublicKeys tracks the public keys published by Vault,
+// so that a test only asserts on the keys of the named key it created while
+// the keys of other named keys come and go.
+type testAccIdentityOidcKeyPublicKeys struct {
+	// published holds the IDs of all keys published at the previous check.
+	published map[string]bool
+	// own holds the IDs of the keys published since the test started.
+	own []string
+}
+
+// init records the keys published before the test creates its named key.
+func (k *testAccIdentityOidcKeyPublicKeys) init(t *testing.T) {
+	client, err := api.NewClient(api.DefaultConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	k.published, err = testAccIdentityOidcKeyReadPublicKeys(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// check verifies that the step published exactly added new keys, or at least
+// one if added is negative, and that the keys published by earlier steps are
+// still published, as their verification TTL has not passed.
+func (k *testAccIdentityOidcKeyPublicKeys) check(added int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		published, err := testAccIdentityOidcKeyReadPublicKeys(client)
+		if err != nil {
+			return err
+		}
+
+		for _, kid := range k.own {
+			if !published[kid] {
+				return fmt.Errorf("public key %q is no longer published", kid)
+			}
+		}
+
+		var newKeys []string
+		for kid := range published {
+			if !k.published[kid] {
+				newKeys = append(newKeys, kid)
+			}
+		}
+		if added < 0 && len(newKeys) == 0 {
+			return fmt.Errorf("expected new public keys to be published, got none")
+		}
+		if added >= 0 && len(newKeys) != added {
+			return fmt.Errorf("expected %d new public keys to be published, got %d", added, len(newKeys))
+		}
+
+		k.published = published
+		k.own = append(k.own, newKeys...)
+
+		return nil
+	}
+}
+
+// testAccIdentityOidcKeyReadPublicKeys returns the IDs of the public keys
+// published by Vault. The keys endpoint responds with a raw JSON Web Key Set
+// rather than a regular secret, so it cannot be read through client.Logical().
+func testAccIdentityOidcKeyReadPublicKeys(client *api.Client) (map[string]bool, error) {
+	resp, err := client.RawRequest(client.NewRequest("GET", "/v1/identity/oidc/.well-known/keys"))
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading OIDC public keys: %s", err)
+	}
+
+	var jwks struct {
+		Keys []struct {
+			KeyID string `json:"kid"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
+		return nil, fmt.Errorf("error decoding OIDC public keys: %s", err)
+	}
+
+	published := make(map[string]bool, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		published[key.KeyID] = true
+	}
+
+	return published, nil
+}
+
 func TestAccIdentityOidcKeyUpdate(t *testing.T) {
 	key := acctest.RandomWithPrefix("test-key")
 
@@ -236,3 +363,13 @@ resource "vault_identity_oidc_key" "key" {
 	allowed_client_ids = ["*"]
 }`, entityName)
 }
+
+func testAccIdentityOidcKeyConfigRotate(entityName, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name           = "%s"
+  rotate_trigger = "%s"
+
+  rotate_verification_ttl = 60
+}`, entityName, trigger)
+}
@@ -118,6 +118,13 @@ func jwtAuthBackendResource() *schema.Resource {
 				Description: "The default role to use if none is provided during login",
 			},
 
+			"namespace_in_state": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Pass namespace in the OIDC state parameter instead of as a separate query parameter. Requires Vault 1.9 or later.",
+			},
+
 			"accessor": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -178,6 +185,7 @@ var (
 		"jwt_supported_algs",
 		"default_role",
 		"provider_config",
+		"namespace_in_state",
 	}
 )
 
@@ -194,6 +202,7 @@ func jwtAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Writing auth %s to Vault", authType)
 
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().EnableAuthWithOptions(path, options)
 
 	if err != nil {
@@ -212,6 +221,7 @@ func jwtAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().DisableAuth(path)
 
 	if err != nil {
@@ -1,12 +1,17 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -36,31 +41,47 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of aud claims to match against. Any match is sufficient.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 		},
+		"validate_audiences": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Fail the plan when a role of type jwt has no bound_audiences, or when any of them contains whitespace. Otherwise a role of type jwt without audiences only produces a warning.",
+		},
 		"user_claim": {
 			Type:        schema.TypeString,
 			Required:    true,
 			Description: "The claim to use to uniquely identify the user; this will be used as the name for the Identity entity alias created due to a successful login.",
 		},
-		"clock_skew_leeway": {
-			Type:        schema.TypeInt,
+		"user_claim_json_pointer": {
+			Type:        schema.TypeBool,
 			Optional:    true,
-			Default:     0,
-			Description: "The amount of leeway to add to all claims to account for clock skew, in seconds. Defaults to 60 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles.",
+			Default:     false,
+			Description: "Specifies if the user_claim value uses JSON pointer syntax for referencing claims. By default, the user_claim value will not use JSON pointer.",
+		},
+		"clock_skew_leeway": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			DiffSuppressFunc: jwtAuthBackendRoleLeewayDiffSuppress,
+			Description:      "The amount of leeway to add to all claims to account for clock skew, in seconds. Defaults to 60 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles.",
 		},
 		"expiration_leeway": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: "The amount of leeway to add to expiration (exp) claims to account for clock skew, in seconds. Defaults to 60 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles.",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			DiffSuppressFunc: jwtAuthBackendRoleLeewayDiffSuppress,
+			Description:      "The amount of leeway to add to expiration (exp) claims to account for clock skew, in seconds. Defaults to 60 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles.",
 		},
 		"not_before_leeway": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     0,
-			Description: "The amount of leeway to add to not before (nbf) claims to account for clock skew, in seconds. Defaults to 150 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles. ",
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Default:          0,
+			DiffSuppressFunc: jwtAuthBackendRoleLeewayDiffSuppress,
+			Description:      "The amount of leeway to add to not before (nbf) claims to account for clock skew, in seconds. Defaults to 150 seconds if set to 0 and can be disabled if set to -1. Only applicable with 'jwt' roles. ",
 		},
 		"allowed_redirect_uris": {
 			Type:     schema.TypeSet,
@@ -189,19 +210,82 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 	})
 
 	return &schema.Resource{
-		Create: jwtAuthBackendRoleCreate,
-		Read:   jwtAuthBackendRoleRead,
-		Update: jwtAuthBackendRoleUpdate,
-		Delete: jwtAuthBackendRoleDelete,
-		Exists: jwtAuthBackendRoleExists,
+		CreateContext: jwtAuthBackendRoleAudienceWarning(jwtAuthBackendRoleCreate),
+		Read:          jwtAuthBackendRoleRead,
+		UpdateContext: jwtAuthBackendRoleAudienceWarning(jwtAuthBackendRoleUpdate),
+		Delete:        jwtAuthBackendRoleDelete,
+		Exists:        jwtAuthBackendRoleExists,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: jwtAuthBackendRoleCustomizeDiff,
 
 		Schema: fields,
 	}
 }
 
+func jwtAuthBackendRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("validate_audiences").(bool) && d.NewValueKnown("bound_audiences") {
+		audiences := d.Get("bound_audiences").(*schema.Set)
+		if d.Get("role_type").(string) == "jwt" && audiences.Len() == 0 {
+			return fmt.Errorf("bound_audiences must be set for roles of type jwt when validate_audiences is true")
+		}
+		for _, v := range audiences.List() {
+			if aud := v.(string); strings.IndexFunc(aud, unicode.IsSpace) >= 0 {
+				return fmt.Errorf("bound_audiences %q must not contain whitespace when validate_audiences is true", aud)
+			}
+		}
+	}
+
+	if !d.Get("user_claim_json_pointer").(bool) || !d.NewValueKnown("user_claim") {
+		return nil
+	}
+
+	if userClaim := d.Get("user_claim").(string); !strings.HasPrefix(userClaim, "/") {
+		return fmt.Errorf("user_claim %q must be a JSON pointer starting with \"/\" when user_claim_json_pointer is true", userClaim)
+	}
+
+	return nil
+}
+
+// jwtAuthBackendRoleAudienceWarning wraps the Create or Update function f to
+// warn about roles of type jwt without bound_audiences. Tokens of such roles
+// are accepted whatever their aud claim, which is rarely intended.
+func jwtAuthBackendRoleAudienceWarning(f func(*schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if err := f(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.Get("role_type").(string) != "jwt" || d.Get("bound_audiences").(*schema.Set).Len() > 0 {
+			return nil
+		}
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("JWT auth backend role %q has no bound_audiences", d.Get("role_name")),
+				Detail: "Tokens are accepted for any audience. Set bound_audiences to the aud claims " +
+					"issued for Vault, or set validate_audiences to reject roles without them.",
+			},
+		}
+	}
+}
+
+// jwtAuthBackendRoleLeewayDefaults holds the values Vault substitutes
+// when a leeway is set to 0.
+var jwtAuthBackendRoleLeewayDefaults = map[string]int{
+	"clock_skew_leeway": 60,
+	"expiration_leeway": 60,
+	"not_before_leeway": 150,
+}
+
+// jwtAuthBackendRoleLeewayDiffSuppress suppresses the diff of a leeway left
+// at 0 when Vault reports its default in its place, as newer versions of
+// Vault do. Any other value reported by Vault is shown as drift.
+func jwtAuthBackendRoleLeewayDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return new == "0" && old == strconv.Itoa(jwtAuthBackendRoleLeewayDefaults[k])
+}
+
 func jwtAuthBackendRoleCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -258,7 +342,9 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	// Check if the user is using the deprecated `policies`
 	if _, deprecated := d.GetOk("policies"); deprecated {
@@ -350,6 +436,15 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.Set("user_claim", resp.Data["user_claim"].(string))
+	if v, ok := resp.Data["user_claim_json_pointer"]; ok {
+		d.Set("user_claim_json_pointer", v)
+	}
+
+	// validate_audiences only exists in Terraform and is missing from
+	// imported state, where its default would otherwise show as a change.
+	if _, ok := d.GetOkExists("validate_audiences"); !ok {
+		d.Set("validate_audiences", false)
+	}
 
 	if resp.Data["allowed_redirect_uris"] != nil {
 		allowedRedirectUris := util.JsonStringArrayToStringArray(resp.Data["allowed_redirect_uris"].([]interface{}))
@@ -401,14 +496,10 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("groups_claim_delimiter_pattern", resp.Data["groups_claim_delimiter_pattern"].(string))
 	}
 
-	if v, ok := resp.Data["clock_skew_leeway"]; ok {
-		d.Set("clock_skew_leeway", v)
-	}
-	if v, ok := resp.Data["expiration_leeway"]; ok {
-		d.Set("expiration_leeway", v)
-	}
-	if v, ok := resp.Data["not_before_leeway"]; ok {
-		d.Set("not_before_leeway", v)
+	for k := range jwtAuthBackendRoleLeewayDefaults {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
 	}
 	if v, ok := resp.Data["verbose_oidc_logging"]; ok {
 		d.Set("verbose_oidc_logging", v)
@@ -516,6 +607,7 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 
 	data["bound_audiences"] = util.TerraformSetToStringArray(d.Get("bound_audiences"))
 	data["user_claim"] = d.Get("user_claim").(string)
+	data["user_claim_json_pointer"] = d.Get("user_claim_json_pointer").(bool)
 
 	if dataList := util.TerraformSetToStringArray(d.Get("allowed_redirect_uris")); len(dataList) > 0 {
 		data["allowed_redirect_uris"] = dataList
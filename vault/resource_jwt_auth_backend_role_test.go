@@ -1,11 +1,15 @@
 package vault
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -430,12 +434,12 @@ func TestAccJWTAuthBackendRole_fullUpdate(t *testing.T) {
 						"bound_claims.department", "engineering-*-admin"),
 					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
 						"bound_claims.sector", "7g"),
-					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
-						"clock_skew_leeway", "0"),
-					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
-						"expiration_leeway", "0"),
-					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
-						"not_before_leeway", "0"),
+					resource.TestMatchResourceAttr("vault_jwt_auth_backend_role.role",
+						"clock_skew_leeway", regexp.MustCompile("^(0|60)$")),
+					resource.TestMatchResourceAttr("vault_jwt_auth_backend_role.role",
+						"expiration_leeway", regexp.MustCompile("^(0|60)$")),
+					resource.TestMatchResourceAttr("vault_jwt_auth_backend_role.role",
+						"not_before_leeway", regexp.MustCompile("^(0|150)$")),
 					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
 						"verbose_oidc_logging", "false"),
 				),
@@ -533,6 +537,178 @@ func TestAccJWTAuthBackendRole_fullDeprecated(t *testing.T) {
 	})
 }
 
+func TestAccJWTAuthBackendRole_userClaimJSONPointer(t *testing.T) {
+	backend := acctest.RandomWithPrefix("jwt")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckJWTAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, "/user/name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim", "/user/name"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim_json_pointer", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_jwt_auth_backend_role.role",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, "name"),
+				ExpectError: regexp.MustCompile(`must be a JSON pointer`),
+			},
+		},
+	})
+}
+
+func TestAccJWTAuthBackendRole_boundAudiencesValidation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("jwt")
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_auth_backend" "jwt" {
+  type = "jwt"
+  path = "%s"
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend   = vault_auth_backend.jwt.path
+  role_name = "%s"
+  role_type = "jwt"
+
+  bound_audiences = ["https://myco.test", " "]
+  user_claim      = "https://vault/user"
+}`, backend, role),
+				ExpectError: regexp.MustCompile(`to not be an empty string or whitespace`),
+			},
+		},
+	})
+}
+
+func TestJWTAuthBackendRoleValidateAudiences(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		expectErr string
+	}{
+		{
+			name: "disabled",
+			raw:  map[string]interface{}{"role_type": "jwt"},
+		},
+		{
+			name:      "jwt without audiences",
+			raw:       map[string]interface{}{"role_type": "jwt", "validate_audiences": true},
+			expectErr: "bound_audiences must be set",
+		},
+		{
+			name: "oidc without audiences",
+			raw:  map[string]interface{}{"role_type": "oidc", "validate_audiences": true},
+		},
+		{
+			name: "audience with whitespace",
+			raw: map[string]interface{}{
+				"role_type":          "jwt",
+				"validate_audiences": true,
+				"bound_audiences":    []interface{}{"https://myco.test", "my co"},
+			},
+			expectErr: `"my co" must not contain whitespace`,
+		},
+		{
+			name: "valid audiences",
+			raw: map[string]interface{}{
+				"role_type":          "jwt",
+				"validate_audiences": true,
+				"bound_audiences":    []interface{}{"https://myco.test"},
+			},
+		},
+	}
+
+	r := jwtAuthBackendRoleResource()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"backend":    "jwt",
+				"role_name":  "test",
+				"user_claim": "sub",
+			}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+
+			_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tt.expectErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !regexp.MustCompile(regexp.QuoteMeta(tt.expectErr)).MatchString(err.Error()) {
+				t.Fatalf("expected an error matching %q, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestJWTAuthBackendRoleAudienceWarning(t *testing.T) {
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		warnings int
+	}{
+		{"jwt without audiences", map[string]interface{}{"role_type": "jwt"}, 1},
+		{"jwt with audiences", map[string]interface{}{"role_type": "jwt", "bound_audiences": []interface{}{"https://myco.test"}}, 0},
+		{"oidc without audiences", map[string]interface{}{"role_type": "oidc"}, 0},
+	}
+
+	write := func(*schema.ResourceData, interface{}) error { return nil }
+	f := jwtAuthBackendRoleAudienceWarning(write)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, jwtAuthBackendRoleResource().Schema, tt.raw)
+			diags := f(context.Background(), d, nil)
+			if len(diags) != tt.warnings {
+				t.Fatalf("expected %d warnings, got %v", tt.warnings, diags)
+			}
+			for _, w := range diags {
+				if w.Severity != diag.Warning {
+					t.Errorf("expected a warning, got %v", w)
+				}
+			}
+		})
+	}
+}
+
+func testAccJWTAuthBackendRoleConfig_userClaimJSONPointer(backend, role, userClaim string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "jwt" {
+  type = "jwt"
+  path = "%s"
+}
+
+resource "vault_jwt_auth_backend_role" "role" {
+  backend   = vault_auth_backend.jwt.path
+  role_name = "%s"
+  role_type = "jwt"
+
+  bound_audiences         = ["https://myco.test"]
+  user_claim              = "%s"
+  user_claim_json_pointer = true
+}`, backend, role, userClaim)
+}
+
 func testAccCheckJWTAuthBackendRoleDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -736,3 +912,27 @@ resource "vault_jwt_auth_backend_role" "role" {
   max_ttl = 10800
 }`, backend, role)
 }
+
+func TestJWTAuthBackendRoleLeewayDiffSuppress(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"default reported for zero", "clock_skew_leeway", "60", "0", true},
+		{"nbf default reported for zero", "not_before_leeway", "150", "0", true},
+		{"other key default", "not_before_leeway", "60", "0", false},
+		{"drift", "expiration_leeway", "120", "0", false},
+		{"disabled", "clock_skew_leeway", "60", "-1", false},
+		{"explicit default", "clock_skew_leeway", "0", "60", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := jwtAuthBackendRoleLeewayDiffSuppress(tt.key, tt.old, tt.new, nil); got != tt.suppress {
+				t.Errorf("jwtAuthBackendRoleLeewayDiffSuppress() got = %t, want %t", got, tt.suppress)
+			}
+		})
+	}
+}
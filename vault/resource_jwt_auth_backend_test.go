@@ -190,6 +190,46 @@ resource "vault_jwt_auth_backend" "jwt" {
 `, jwks, boundIssuer, supportedAlgs, path)
 }
 
+func TestAccJWTAuthBackend_namespaceInState(t *testing.T) {
+	path := acctest.RandomWithPrefix("oidc")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testJWTAuthBackend_Destroyed(path),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJWTAuthBackendConfigNamespaceInState(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend.oidc", "bound_issuer", "api://default"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend.oidc", "namespace_in_state", "false"),
+				),
+			},
+			{
+				Config: testAccJWTAuthBackendConfigNamespaceInState(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend.oidc", "bound_issuer", "api://default"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend.oidc", "namespace_in_state", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccJWTAuthBackendConfigNamespaceInState(path string, namespaceInState bool) string {
+	return fmt.Sprintf(`
+resource "vault_jwt_auth_backend" "oidc" {
+  description = "OIDC backend"
+  oidc_discovery_url = "https://myco.auth0.com/"
+  oidc_client_id = "client"
+  oidc_client_secret = "secret"
+  bound_issuer = "api://default"
+  path = "%s"
+  type = "oidc"
+  namespace_in_state = %t
+}
+`, path, namespaceInState)
+}
+
 func testAccJWTAuthBackendConfigOIDC(path string) string {
 	return fmt.Sprintf(`
 resource "vault_jwt_auth_backend" "oidc" {
@@ -0,0 +1,125 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const keyRotationConfigPath = "sys/rotate/config"
+
+func keyRotationConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keyRotationConfigWrite,
+		Update: keyRotationConfigWrite,
+		Read:   keyRotationConfigRead,
+		Delete: keyRotationConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether automatic rotation of the barrier key is enabled.",
+			},
+			"max_operations": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Number of encryption operations after which the barrier key is rotated.",
+			},
+			"interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Time interval after which the barrier key is rotated. Must be at least 24 hours.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: util.DurationDiffSuppress,
+			},
+		},
+	}
+}
+
+func keyRotationConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// An interval of 0 disables time based rotation.
+	interval := d.Get("interval").(string)
+	if interval == "" {
+		interval = "0"
+	}
+
+	data := map[string]interface{}{
+		"enabled":  d.Get("enabled").(bool),
+		"interval": interval,
+	}
+	if v, ok := d.GetOk("max_operations"); ok {
+		data["max_operations"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing key rotation config to %q", keyRotationConfigPath)
+	if _, err := client.Logical().Write(keyRotationConfigPath, data); err != nil {
+		return fmt.Errorf("error writing key rotation config to %q: %s", keyRotationConfigPath, err)
+	}
+	log.Printf("[DEBUG] Wrote key rotation config to %q", keyRotationConfigPath)
+
+	d.SetId(keyRotationConfigPath)
+
+	return keyRotationConfigRead(d, meta)
+}
+
+func keyRotationConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading key rotation config from %q", keyRotationConfigPath)
+	resp, err := client.Logical().Read(keyRotationConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading key rotation config from %q: %s", keyRotationConfigPath, err)
+	}
+	log.Printf("[DEBUG] Read key rotation config from %q", keyRotationConfigPath)
+	if resp == nil {
+		log.Printf("[WARN] Key rotation config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{"enabled", "max_operations"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q on key rotation config: %s", k, err)
+		}
+	}
+
+	// Vault omits the interval when time based rotation is disabled.
+	interval, _ := resp.Data["interval"].(string)
+	if interval == "0s" {
+		interval = ""
+	}
+	if err := d.Set("interval", interval); err != nil {
+		return fmt.Errorf("error setting state key %q on key rotation config: %s", "interval", err)
+	}
+
+	return nil
+}
+
+func keyRotationConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// The config cannot be removed, so restore Vault's defaults instead.
+	data := map[string]interface{}{
+		"enabled":  true,
+		"interval": "0",
+	}
+
+	log.Printf("[DEBUG] Resetting key rotation config at %q", keyRotationConfigPath)
+	if _, err := client.Logical().Write(keyRotationConfigPath, data); err != nil {
+		return fmt.Errorf("error resetting key rotation config at %q: %s", keyRotationConfigPath, err)
+	}
+	log.Printf("[DEBUG] Reset key rotation config at %q", keyRotationConfigPath)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKeyRotationConfig(t *testing.T) {
+	resourceName := "vault_key_rotation_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vault_key_rotation_config" "test" {
+  max_operations = 3000000000
+  interval       = "720h"
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_operations", "3000000000"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "720h0m0s"),
+				),
+			},
+			{
+				Config: `
+resource "vault_key_rotation_config" "test" {
+  enabled = false
+}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interval", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
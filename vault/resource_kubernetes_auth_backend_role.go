@@ -241,7 +241,9 @@ func kubernetesAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		return nil
 	}
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
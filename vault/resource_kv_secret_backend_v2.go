@@ -0,0 +1,250 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// kvSecretBackendV2DefaultMaxVersions is the number of versions Vault keeps
+// when max_versions is 0.
+const kvSecretBackendV2DefaultMaxVersions = 10
+
+func kvSecretBackendV2Resource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretBackendV2Write,
+		Read:   kvSecretBackendV2Read,
+		Update: kvSecretBackendV2Write,
+		Delete: kvSecretBackendV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"max_versions": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "The number of versions to keep for each key. " +
+					"Defaults to 10, which is also what 0 means to Vault.",
+				ValidateFunc:     validation.IntAtLeast(0),
+				DiffSuppressFunc: kvSecretBackendV2MaxVersionsDiffSuppress,
+			},
+			"delete_version_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Number of seconds or duration string after which versions are deleted. " +
+					"0 or unset disables deletion.",
+				ValidateFunc:     kvSecretBackendV2ValidateDeleteVersionAfter,
+				DiffSuppressFunc: kvSecretBackendV2DeleteVersionAfterDiffSuppress,
+			},
+			"cas_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Require the cas parameter on all writes to the engine.",
+			},
+		},
+	}
+}
+
+func kvSecretBackendV2Write(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	path := kvSecretBackendV2ConfigPath(mount)
+
+	// Validated in the schema already.
+	deleteVersionAfter, _ := kvSecretBackendV2ParseSeconds(d.Get("delete_version_after").(string))
+
+	data := map[string]interface{}{
+		"max_versions":         d.Get("max_versions").(int),
+		"delete_version_after": fmt.Sprintf("%ds", deleteVersionAfter),
+		"cas_required":         d.Get("cas_required").(bool),
+	}
+
+	log.Printf("[DEBUG] Writing KV-V2 backend config to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV-V2 backend config to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV-V2 backend config to %q", path)
+
+	d.SetId(path)
+	return kvSecretBackendV2Read(d, meta)
+}
+
+func kvSecretBackendV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	mount := strings.TrimSuffix(path, "/config")
+
+	log.Printf("[DEBUG] Reading KV-V2 backend config from %q", path)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		if util.IsMountNotFoundError(err) || util.Is404(err) {
+			log.Printf("[WARN] KV-V2 backend %q not found, removing from state", mount)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading KV-V2 backend config from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 backend config from %q", path)
+
+	if config == nil {
+		log.Printf("[WARN] KV-V2 backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	maxVersions, err := kvSecretBackendV2MaxVersions(config.Data["max_versions"])
+	if err != nil {
+		return fmt.Errorf("error reading max_versions from %q: %s", path, err)
+	}
+	deleteVersionAfter, err := kvSecretBackendV2DeleteVersionAfter(config.Data["delete_version_after"])
+	if err != nil {
+		return fmt.Errorf("error reading delete_version_after from %q: %s", path, err)
+	}
+
+	d.Set("mount", mount)
+	if err := d.Set("max_versions", maxVersions); err != nil {
+		return err
+	}
+	if err := d.Set("delete_version_after", strconv.Itoa(deleteVersionAfter)); err != nil {
+		return err
+	}
+	if v, ok := config.Data["cas_required"].(bool); ok {
+		d.Set("cas_required", v)
+	}
+
+	return nil
+}
+
+func kvSecretBackendV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// The config cannot be removed, so restore Vault's defaults instead.
+	log.Printf("[DEBUG] Resetting KV-V2 backend config %q", path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"max_versions":         0,
+		"delete_version_after": "0s",
+		"cas_required":         false,
+	})
+	if err != nil && !util.IsMountNotFoundError(err) && !util.Is404(err) {
+		return fmt.Errorf("error resetting KV-V2 backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset KV-V2 backend config %q", path)
+
+	return nil
+}
+
+func kvSecretBackendV2ConfigPath(mount string) string {
+	return strings.Trim(mount, "/") + "/config"
+}
+
+// kvSecretBackendV2MaxVersionsDiffSuppress treats 0 and Vault's default
+// number of versions as the same setting.
+func kvSecretBackendV2MaxVersionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	normalize := func(s string) string {
+		if s == "" || s == strconv.Itoa(kvSecretBackendV2DefaultMaxVersions) {
+			return "0"
+		}
+		return s
+	}
+	return normalize(old) == normalize(new)
+}
+
+func kvSecretBackendV2MaxVersions(v interface{}) (int, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err
+	default:
+		return 0, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// kvSecretBackendV2DeleteVersionAfter returns delete_version_after in
+// seconds. Vault omits the field or reports "0s" when deletion is disabled,
+// both of which map to 0.
+func kvSecretBackendV2DeleteVersionAfter(v interface{}) (int, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err
+	case string:
+		if v == "" {
+			return 0, nil
+		}
+		dur, err := time.ParseDuration(v)
+		if err != nil {
+			return 0, err
+		}
+		return int(dur.Seconds()), nil
+	default:
+		return 0, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+// kvSecretBackendV2ParseSeconds parses delete_version_after, given either as
+// a number of seconds or as a duration string, into seconds.
+func kvSecretBackendV2ParseSeconds(s string) (int, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if i, err := strconv.Atoi(s); err == nil {
+		return i, nil
+	}
+	dur, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	return int(dur.Seconds()), nil
+}
+
+func kvSecretBackendV2ValidateDeleteVersionAfter(i interface{}, k string) ([]string, []error) {
+	seconds, err := kvSecretBackendV2ParseSeconds(i.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be a number of seconds or a duration string, got %q", k, i)}
+	}
+	if seconds < 0 {
+		return nil, []error{fmt.Errorf("expected %s to not be negative, got %q", k, i)}
+	}
+	return nil, nil
+}
+
+// kvSecretBackendV2DeleteVersionAfterDiffSuppress treats equal durations as
+// the same setting, however they are written.
+func kvSecretBackendV2DeleteVersionAfterDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldSeconds, err := kvSecretBackendV2ParseSeconds(old)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := kvSecretBackendV2ParseSeconds(new)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
@@ -0,0 +1,207 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKVSecretBackendV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("kvv2")
+	resourceName := "vault_kv_secret_backend_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccKVSecretBackendV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretBackendV2Config(mount, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount", mount),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "0"),
+				),
+			},
+			{
+				Config: testAccKVSecretBackendV2Config(mount, `
+  max_versions         = 0
+  delete_version_after = 0`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "0"),
+				),
+			},
+			{
+				Config: testAccKVSecretBackendV2Config(mount, `
+  max_versions         = 5
+  delete_version_after = 3600`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "5"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "3600"),
+				),
+			},
+			{
+				Config: testAccKVSecretBackendV2Config(mount, `
+  max_versions         = 5
+  delete_version_after = "1h"
+  cas_required         = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Explicitly setting Vault's default must not cause a perpetual diff.
+				Config: testAccKVSecretBackendV2Config(mount, `
+  max_versions = 10`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "10"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "0"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "false"),
+				),
+			},
+			{
+				Config: testAccKVSecretBackendV2Config(mount, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestKVSecretBackendV2DeleteVersionAfter(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want int
+	}{
+		{"absent", nil, 0},
+		{"empty", "", 0},
+		{"disabled", "0s", 0},
+		{"duration", "1h0m0s", 3600},
+		{"number", json.Number("90"), 90},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := kvSecretBackendV2DeleteVersionAfter(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("kvSecretBackendV2DeleteVersionAfter() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := kvSecretBackendV2DeleteVersionAfter("soon"); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+}
+
+func TestKVSecretBackendV2DeleteVersionAfterDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{"0", "", true},
+		{"0", "0s", true},
+		{"3600", "1h", true},
+		{"3600", "60m", true},
+		{"3600", "3600", true},
+		{"3600", "2h", false},
+		{"0", "90", false},
+		{"3600", "soon", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s-%s", tt.old, tt.new), func(t *testing.T) {
+			if got := kvSecretBackendV2DeleteVersionAfterDiffSuppress("delete_version_after", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("kvSecretBackendV2DeleteVersionAfterDiffSuppress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	for _, v := range []string{"soon", "-1", "-1h"} {
+		if _, errs := kvSecretBackendV2ValidateDeleteVersionAfter(v, "delete_version_after"); len(errs) == 0 {
+			t.Errorf("expected an error validating %q", v)
+		}
+	}
+}
+
+func TestKVSecretBackendV2MaxVersionsDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old  string
+		new  string
+		want bool
+	}{
+		{"0", "0", true},
+		{"", "0", true},
+		{"0", "10", true},
+		{"10", "0", true},
+		{"0", "5", false},
+		{"5", "10", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s-%s", tt.old, tt.new), func(t *testing.T) {
+			if got := kvSecretBackendV2MaxVersionsDiffSuppress("max_versions", tt.old, tt.new, nil); got != tt.want {
+				t.Errorf("kvSecretBackendV2MaxVersionsDiffSuppress() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func testAccKVSecretBackendV2CheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kv_secret_backend_v2" {
+			continue
+		}
+		mount := rs.Primary.Attributes["mount"]
+		if _, ok := mounts[mount+"/"]; ok {
+			return fmt.Errorf("KV-V2 mount %q still exists", mount)
+		}
+	}
+	return nil
+}
+
+func testAccKVSecretBackendV2Config(mount, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_kv_secret_backend_v2" "test" {
+  mount = vault_mount.kvv2.path%s
+}
+`, mount, extra)
+}
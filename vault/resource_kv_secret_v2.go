@@ -0,0 +1,264 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var kvSecretV2PathRegex = regexp.MustCompile("^(.+?)/data/(.+)$")
+
+func kvSecretV2Resource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretV2Write,
+		Update: kvSecretV2Write,
+		Read:   kvSecretV2Read,
+		Delete: kvSecretV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Full name of the secret. For a nested secret, " +
+					"the name is the nested path excluding the mount and data prefix.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret is written.",
+			},
+			"data_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "JSON-encoded secret data to write.",
+				StateFunc:    NormalizeDataJSON,
+				ValidateFunc: ValidateDataJSON,
+				Sensitive:    true,
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of strings read from Vault.",
+				Sensitive:   true,
+			},
+			"delete_version_after": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Duration after which versions of this secret are deleted. " +
+					"If unset, the mount's delete_version_after is used.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: util.DurationDiffSuppress,
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata of the secret, written to its metadata rather than its data.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"delete_all_versions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Delete all versions and the metadata of the secret on destroy, " +
+					"instead of only its latest version.",
+			},
+		},
+	}
+}
+
+func kvSecretV2Write(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := d.Get("mount").(string)
+	name := d.Get("name").(string)
+	path := kvSecretV2DataPath(mount, name)
+
+	if d.IsNewResource() || d.HasChange("data_json") {
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data); err != nil {
+			return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+		}
+
+		log.Printf("[DEBUG] Writing KV-V2 secret to %q", path)
+		if _, err := client.Logical().Write(path, map[string]interface{}{
+			"data": data,
+		}); err != nil {
+			return fmt.Errorf("error writing KV-V2 secret to %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Wrote KV-V2 secret to %q", path)
+	}
+
+	d.SetId(path)
+
+	// Metadata is written separately from the data, so changing it does not
+	// create a new version of the secret.
+	metadata := map[string]interface{}{}
+	if d.HasChange("delete_version_after") {
+		// An empty value resets the secret to the mount's default.
+		deleteVersionAfter := d.Get("delete_version_after").(string)
+		if deleteVersionAfter == "" {
+			deleteVersionAfter = "0s"
+		}
+		metadata["delete_version_after"] = deleteVersionAfter
+	}
+	if d.HasChange("custom_metadata") {
+		metadata["custom_metadata"] = d.Get("custom_metadata")
+	}
+
+	if len(metadata) > 0 {
+		metadataPath := kvSecretV2MetadataPath(mount, name)
+
+		log.Printf("[DEBUG] Writing KV-V2 secret metadata to %q", metadataPath)
+		if _, err := client.Logical().Write(metadataPath, metadata); err != nil {
+			return fmt.Errorf("error writing KV-V2 secret metadata to %q: %s", metadataPath, err)
+		}
+		log.Printf("[DEBUG] Wrote KV-V2 secret metadata to %q", metadataPath)
+	}
+
+	return kvSecretV2Read(d, meta)
+}
+
+func kvSecretV2Read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	mount, name, err := kvSecretV2MountAndNameFromPath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading KV-V2 secret %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 secret %q: %s", path, err)
+	}
+	if resp == nil || resp.Data["data"] == nil {
+		log.Printf("[WARN] KV-V2 secret %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read KV-V2 secret %q", path)
+
+	data, ok := resp.Data["data"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("unexpected data for KV-V2 secret %q: %#v", path, resp.Data["data"])
+	}
+
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+	}
+
+	// Non-string values are serialized as JSON, the same way
+	// vault_generic_secret does.
+	dataMap := map[string]string{}
+	for k, v := range data {
+		if vs, ok := v.(string); ok {
+			dataMap[k] = vs
+		} else {
+			vBytes, _ := json.Marshal(v)
+			dataMap[k] = string(vBytes)
+		}
+	}
+
+	d.Set("mount", mount)
+	d.Set("name", name)
+	d.Set("path", path)
+	// Not stored in Vault, this keeps the default when importing.
+	d.Set("delete_all_versions", d.Get("delete_all_versions"))
+	if err := d.Set("data_json", string(jsonData)); err != nil {
+		return err
+	}
+	if err := d.Set("data", dataMap); err != nil {
+		return err
+	}
+
+	metadataPath := kvSecretV2MetadataPath(mount, name)
+	log.Printf("[DEBUG] Reading KV-V2 secret metadata %q", metadataPath)
+	metadata, err := client.Logical().Read(metadataPath)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 secret metadata %q: %s", metadataPath, err)
+	}
+	log.Printf("[DEBUG] Read KV-V2 secret metadata %q", metadataPath)
+
+	if metadata != nil {
+		// Vault reports "0s" when the secret inherits the mount's setting.
+		deleteVersionAfter, _ := metadata.Data["delete_version_after"].(string)
+		if deleteVersionAfter == "0s" {
+			deleteVersionAfter = ""
+		}
+		if err := d.Set("delete_version_after", deleteVersionAfter); err != nil {
+			return err
+		}
+
+		customMetadata, _ := metadata.Data["custom_metadata"].(map[string]interface{})
+		if err := d.Set("custom_metadata", customMetadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func kvSecretV2Delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if d.Get("delete_all_versions").(bool) {
+		mount, name, err := kvSecretV2MountAndNameFromPath(path)
+		if err != nil {
+			return err
+		}
+		path = kvSecretV2MetadataPath(mount, name)
+	}
+
+	log.Printf("[DEBUG] Deleting KV-V2 secret %q", path)
+	_, err := client.Logical().Delete(path)
+	if err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting KV-V2 secret %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KV-V2 secret %q", path)
+
+	return nil
+}
+
+func kvSecretV2DataPath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/data/" + strings.Trim(name, "/")
+}
+
+func kvSecretV2MetadataPath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/metadata/" + strings.Trim(name, "/")
+}
+
+func kvSecretV2MountAndNameFromPath(path string) (string, string, error) {
+	res := kvSecretV2PathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("invalid path %q for KV-V2 secret, expected <mount>/data/<name>", path)
+	}
+	return res[1], res[2], nil
+}
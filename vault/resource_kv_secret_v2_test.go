@@ -0,0 +1,202 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKVSecretV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("kvv2")
+	name := acctest.RandomWithPrefix("secret")
+	resourceName := "vault_kv_secret_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccKVSecretV2CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKVSecretV2Config(mount, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mount", mount),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "path", mount+"/data/"+name),
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", ""),
+				),
+			},
+			{
+				Config: testAccKVSecretV2Config(mount, name, `delete_version_after = "1h"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data.zip", "zap"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "1h0m0s"),
+				),
+			},
+			{
+				Config: testAccKVSecretV2Config(mount, name, `delete_version_after = "60m"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", "1h0m0s"),
+				),
+			},
+			{
+				Config: testAccKVSecretV2Config(mount, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after", ""),
+				),
+			},
+			{
+				Config: testAccKVSecretV2Config(mount, name, `custom_metadata = { team = "platform", rotation = "90d" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.team", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.rotation", "90d"),
+					testAccKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKVSecretV2Config(mount, name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "0"),
+					testAccKVSecretV2CheckCurrentVersion(mount, name, 1),
+				),
+			},
+		},
+	})
+}
+
+// testAccKVSecretV2CheckCurrentVersion checks that the secret has the expected
+// number of versions, e.g. that metadata updates did not create a new one.
+func testAccKVSecretV2CheckCurrentVersion(mount, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		metadataPath := kvSecretV2MetadataPath(mount, name)
+		metadata, err := client.Logical().Read(metadataPath)
+		if err != nil {
+			return err
+		}
+		if metadata == nil {
+			return fmt.Errorf("KV-V2 secret metadata %q not found", metadataPath)
+		}
+		if v := fmt.Sprint(metadata.Data["current_version"]); v != fmt.Sprint(expected) {
+			return fmt.Errorf("expected current_version %d, got %s", expected, v)
+		}
+		return nil
+	}
+}
+
+func TestAccKVSecretV2_deleteAllVersions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("kvv2")
+	name := acctest.RandomWithPrefix("secret")
+	resourceName := "vault_kv_secret_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+
+			// The mount is managed outside of Terraform, so that it still
+			// exists when the secret's metadata is checked on destroy.
+			client := testProvider.Meta().(*api.Client)
+			if err := client.Sys().Mount(mount, &api.MountInput{
+				Type:    "kv",
+				Options: map[string]string{"version": "2"},
+			}); err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				if err := client.Sys().Unmount(mount); err != nil {
+					t.Error(err)
+				}
+			})
+		},
+		Providers:    testProviders,
+		CheckDestroy: testAccKVSecretV2CheckMetadataDestroy(mount, name),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_kv_secret_v2" "test" {
+  mount               = "%s"
+  name                = "%s"
+  delete_all_versions = true
+
+  data_json = jsonencode(
+    {
+      zip = "zap"
+    }
+  )
+}
+`, mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_all_versions", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKVSecretV2CheckMetadataDestroy(mount, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		metadata, err := client.Logical().Read(kvSecretV2MetadataPath(mount, name))
+		if err != nil {
+			return err
+		}
+		if metadata != nil {
+			return fmt.Errorf("KV-V2 secret metadata %q still exists", kvSecretV2MetadataPath(mount, name))
+		}
+		return nil
+	}
+}
+
+func testAccKVSecretV2CheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kv_secret_v2" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil && secret.Data["data"] != nil {
+			return fmt.Errorf("KV-V2 secret %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKVSecretV2Config(mount, name, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "kvv2" {
+  path    = "%s"
+  type    = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_kv_secret_v2" "test" {
+  mount = vault_mount.kvv2.path
+  name  = "%s"
+  %s
+
+  data_json = jsonencode(
+    {
+      zip = "zap"
+    }
+  )
+}
+`, mount, name, extra)
+}
@@ -54,6 +54,7 @@ func ldapAuthBackendResource() *schema.Resource {
 			Computed:  true,
 			Sensitive: true,
 		},
+		"bindpass_version": writeOnlyVersionSchema("bindpass"),
 		"userdn": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -175,6 +176,7 @@ func ldapAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Enabling LDAP auth backend %q", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().EnableAuthWithOptions(path, options)
 	if err != nil {
 		return fmt.Errorf("error enabling ldap auth backend %q: %s", path, err)
@@ -220,8 +222,10 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["binddn"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("bindpass"); ok {
-		data["bindpass"] = v.(string)
+	if writeOnlyChanged(d, "bindpass", "bindpass_version") {
+		if v, ok := d.GetOk("bindpass"); ok {
+			data["bindpass"] = v.(string)
+		}
 	}
 
 	if v, ok := d.GetOk("userdn"); ok {
@@ -349,6 +353,7 @@ func ldapAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	log.Printf("[DEBUG] Deleting LDAP auth backend %q", path)
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().DisableAuth(path)
 	if err != nil {
 		return fmt.Errorf("error deleting ldap auth backend %q: %q", path, err)
@@ -132,7 +132,7 @@ func ldapAuthBackendGroupResourceDelete(d *schema.ResourceData, meta interface{}
 	log.Printf("[DEBUG] Deleting LDAP group %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("error deleting ldap group %q", path)
+		return fmt.Errorf("error deleting ldap group %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Deleted LDAP group %q", path)
 
@@ -41,6 +41,77 @@ func TestLDAPAuthBackendGroup_import(t *testing.T) {
 	})
 }
 
+func TestLDAPAuthBackendGroup_pathRegex(t *testing.T) {
+	tests := map[string]struct {
+		path          string
+		wantBackend   string
+		wantGroupname string
+	}{
+		"no nesting": {
+			path:          "auth/ldap/groups/dba",
+			wantBackend:   "ldap",
+			wantGroupname: "dba",
+		},
+		"nested": {
+			path:          "auth/corp/ldap/groups/dba",
+			wantBackend:   "corp/ldap",
+			wantGroupname: "dba",
+		},
+		"special characters": {
+			path:          "auth/ldap/groups/Domain Admins?#%",
+			wantBackend:   "ldap",
+			wantGroupname: "Domain Admins?#%",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			backend, err := ldapAuthBackendGroupBackendFromPath(tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if backend != tc.wantBackend {
+				t.Fatalf("expected backend %q, got %q", tc.wantBackend, backend)
+			}
+
+			groupname, err := ldapAuthBackendGroupNameFromPath(tc.path)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if groupname != tc.wantGroupname {
+				t.Fatalf("expected groupname %q, got %q", tc.wantGroupname, groupname)
+			}
+		})
+	}
+}
+
+func TestLDAPAuthBackendGroup_escapedName(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap-backend")
+	// The Vault client escapes the name, so characters such as spaces,
+	// "?" and "#" must round trip without being cut off.
+	groupname := acctest.RandomWithPrefix("tf test ldap group?#%")
+
+	policies := []string{
+		acctest.RandomWithPrefix("policy"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendGroupConfig_basic(backend, groupname, policies),
+				Check:  testLDAPAuthBackendGroupCheck_attrs(backend, groupname),
+			},
+			{
+				ResourceName:      "vault_ldap_auth_backend_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestLDAPAuthBackendGroup_basic(t *testing.T) {
 	backend := acctest.RandomWithPrefix("tf-test-ldap-backend")
 	groupname := acctest.RandomWithPrefix("tf-test-ldap-group")
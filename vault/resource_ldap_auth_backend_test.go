@@ -67,6 +67,34 @@ func TestLDAPAuthBackend_basic(t *testing.T) {
 	})
 }
 
+func TestLDAPAuthBackend_bindpassVersion(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-ldap-path")
+	resourceName := "vault_ldap_auth_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendConfig_bindpassVersion(path, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testLDAPAuthBackendCheck_attrs(path),
+					resource.TestCheckResourceAttr(resourceName, "bindpass_version", "1"),
+				),
+			},
+			{
+				// Writes bindpass again, even though it is unchanged.
+				Config: testLDAPAuthBackendConfig_bindpassVersion(path, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testLDAPAuthBackendCheck_attrs(path),
+					resource.TestCheckResourceAttr(resourceName, "bindpass_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestLDAPAuthBackend_tls(t *testing.T) {
 	path := acctest.RandomWithPrefix("tf-test-ldap-tls-path")
 
@@ -287,6 +315,19 @@ resource "vault_ldap_auth_backend" "test" {
 
 }
 
+func testLDAPAuthBackendConfig_bindpassVersion(path, bindpassVersion string) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_auth_backend" "test" {
+    path             = "%s"
+    url              = "ldaps://example.org"
+    binddn           = "cn=example.com"
+    bindpass         = "supersecurepassword"
+    bindpass_version = "%s"
+    description      = "example"
+}
+`, path, bindpassVersion)
+}
+
 func testLDAPAuthBackendConfig_tls(path, use_token_groups string, local string) string {
 
 	return fmt.Sprintf(`
@@ -33,6 +33,18 @@ func ldapAuthBackendUserResource() *schema.Resource {
 			"username": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
+				// Vault lowercases usernames unless the backend is
+				// configured with case_sensitive_names, in which case
+				// changing the case renames the user.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return !d.Get("case_sensitive_names").(bool) && strings.EqualFold(old, new)
+				},
+			},
+			"case_sensitive_names": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the backend keeps the case of usernames rather than lowercasing them.",
 			},
 			"policies": {
 				Type: schema.TypeSet,
@@ -40,7 +52,6 @@ func ldapAuthBackendUserResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional: true,
-				Computed: true,
 			},
 			"groups": {
 				Type: schema.TypeSet,
@@ -48,7 +59,6 @@ func ldapAuthBackendUserResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional: true,
-				Computed: true,
 			},
 			"backend": {
 				Type:     schema.TypeString,
@@ -72,29 +82,34 @@ func ldapAuthBackendUserResourceWrite(d *schema.ResourceData, meta interface{})
 
 	backend := d.Get("backend").(string)
 	username := d.Get("username").(string)
-	path := ldapAuthBackendUserResourcePath(backend, username)
-
-	data := map[string]interface{}{}
 
-	if v, ok := d.GetOk("policies"); ok {
-		data["policies"] = v.(*schema.Set).List()
+	path := d.Id()
+	if path == "" {
+		caseSensitive, err := ldapAuthBackendCaseSensitiveNames(client, backend)
+		if err != nil {
+			return err
+		}
+		if !caseSensitive {
+			username = strings.ToLower(username)
+		}
+		path = ldapAuthBackendUserResourcePath(backend, username)
 	}
 
-	if v, ok := d.GetOk("groups"); ok {
-		data["groups"] = strings.Join(util.ToStringArray(v.(*schema.Set).List()), ",")
+	// Both fields are always sent so that removing all policies or groups
+	// from the configuration clears them in Vault.
+	data := map[string]interface{}{
+		"policies": util.ToStringArray(d.Get("policies").(*schema.Set).List()),
+		"groups":   strings.Join(util.ToStringArray(d.Get("groups").(*schema.Set).List()), ","),
 	}
 
 	log.Printf("[DEBUG] Writing LDAP user %q", path)
-	_, err := client.Logical().Write(path, data)
-
-	d.SetId(path)
-
-	if err != nil {
-		d.SetId("")
+	if _, err := client.Logical().Write(path, data); err != nil {
 		return fmt.Errorf("error writing ldap user %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Wrote LDAP user %q", path)
 
+	d.SetId(path)
+
 	return ldapAuthBackendUserResourceRead(d, meta)
 }
 
@@ -125,24 +140,40 @@ func ldapAuthBackendUserResourceRead(d *schema.ResourceData, meta interface{}) e
 		return nil
 	}
 
-	d.Set("policies",
-		schema.NewSet(
-			schema.HashString, resp.Data["policies"].([]interface{})))
+	policies := []interface{}{}
+	if v, ok := resp.Data["policies"].([]interface{}); ok {
+		policies = v
+	}
+	if err := d.Set("policies", schema.NewSet(schema.HashString, policies)); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
 
 	groupSet := schema.NewSet(schema.HashString, []interface{}{})
 	// Vault stores `groups` for an LDAP user as a string, not a list. We explicitly check
 	// for an empty string here because without it, there exists a logical mismatch between
 	// an empty set/list and the result of creating a list by splitting on an empty string.
-	if resp.Data["groups"].(string) != "" {
-		for _, group := range strings.Split(resp.Data["groups"].(string), ",") {
-			groupSet.Add(group)
+	if groups, _ := resp.Data["groups"].(string); groups != "" {
+		for _, group := range strings.Split(groups, ",") {
+			groupSet.Add(strings.TrimSpace(group))
 		}
 	}
-	d.Set("groups", groupSet)
+	if err := d.Set("groups", groupSet); err != nil {
+		return fmt.Errorf("error setting groups: %s", err)
+	}
 
 	d.Set("backend", backend)
 	d.Set("username", username)
 
+	// Reading the backend's config needs an additional capability, so
+	// failing to read it keeps the previous value rather than failing
+	// the refresh.
+	caseSensitive, err := ldapAuthBackendCaseSensitiveNames(client, backend)
+	if err != nil {
+		log.Printf("[WARN] Unable to read case_sensitive_names of LDAP backend %q: %s", backend, err)
+	} else {
+		d.Set("case_sensitive_names", caseSensitive)
+	}
+
 	return nil
 
 }
@@ -154,7 +185,7 @@ func ldapAuthBackendUserResourceDelete(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Deleting LDAP user %q", path)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("error deleting ldap user %q", path)
+		return fmt.Errorf("error deleting ldap user %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Deleted LDAP user %q", path)
 
@@ -175,6 +206,21 @@ func ldapAuthBackendUserResourceExists(d *schema.ResourceData, meta interface{})
 	return resp != nil, nil
 }
 
+// ldapAuthBackendCaseSensitiveNames reports whether the LDAP backend keeps
+// the case of user and group names rather than lowercasing them.
+func ldapAuthBackendCaseSensitiveNames(client *api.Client, backend string) (bool, error) {
+	path := ldapAuthBackendConfigPath(backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return false, fmt.Errorf("error reading ldap config %q: %s", path, err)
+	}
+	if resp == nil {
+		return false, nil
+	}
+	caseSensitive, _ := resp.Data["case_sensitive_names"].(bool)
+	return caseSensitive, nil
+}
+
 func ldapAuthBackendUserNameFromPath(path string) (string, error) {
 	if !ldapAuthBackendUserNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no user found")
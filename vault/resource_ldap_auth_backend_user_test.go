@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -129,6 +130,96 @@ func TestLDAPAuthBackendUser_oneGroup(t *testing.T) {
 	})
 }
 
+func TestLDAPAuthBackendUser_mixedCase(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap-backend")
+	username := acctest.RandomWithPrefix("TF-Test-LDAP-User")
+
+	policies := []string{
+		acctest.RandomWithPrefix("policy"),
+	}
+
+	groups := []string{
+		acctest.RandomWithPrefix("group"),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testLDAPAuthBackendUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPAuthBackendUserConfig_basic(backend, username, policies, groups),
+				Check: resource.ComposeTestCheckFunc(
+					testLDAPAuthBackendUserCheck_attrs(backend, strings.ToLower(username)),
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_user.test", "username", strings.ToLower(username)),
+				),
+			},
+			{
+				// Only the policies are removed, the groups must be kept.
+				Config: testLDAPAuthBackendUserConfig_basic(backend, username, []string{}, groups),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_user.test", "policies.#", "0"),
+					testLDAPAuthBackendUserCheck_attrs(backend, strings.ToLower(username)),
+					testLDAPAuthBackendUserCheck_groups(backend, username, groups),
+				),
+			},
+			{
+				Config: testLDAPAuthBackendUserConfig_basic(backend, username, []string{}, []string{}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ldap_auth_backend_user.test", "groups.#", "0"),
+					testLDAPAuthBackendUserCheck_groups(backend, username, []string{}),
+				),
+			},
+			{
+				ResourceName:      "vault_ldap_auth_backend_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestLDAPAuthBackendUserUsernameCase(t *testing.T) {
+	tests := []struct {
+		name          string
+		caseSensitive bool
+		username      string
+		requiresNew   bool
+	}{
+		{"case insensitive, case changed", false, "Alice", false},
+		{"case insensitive, renamed", false, "bob", true},
+		{"case sensitive, case changed", true, "Alice", true},
+		{"case sensitive, unchanged", true, "alice", false},
+	}
+
+	r := ldapAuthBackendUserResource()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "auth/ldap/users/alice",
+				Attributes: map[string]string{
+					"id":                   "auth/ldap/users/alice",
+					"backend":              "ldap",
+					"username":             "alice",
+					"case_sensitive_names": strconv.FormatBool(tt.caseSensitive),
+				},
+			}
+			c := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"backend":  "ldap",
+				"username": tt.username,
+			})
+
+			diff, err := r.Diff(context.Background(), state, c, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tt.requiresNew {
+				t.Fatalf("expected RequiresNew %t, got %t", tt.requiresNew, got)
+			}
+		})
+	}
+}
+
 func testLDAPAuthBackendUserDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
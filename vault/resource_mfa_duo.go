@@ -3,7 +3,6 @@ package vault
 import (
 	"fmt"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -77,7 +76,7 @@ func mfaDuoWrite(d *schema.ResourceData, meta interface{}) error {
 	_, err := client.Logical().Write(mfaDuoPath(name), data)
 
 	if err != nil {
-		return fmt.Errorf("error writing to Vault: %s", err)
+		return mfaLegacyWriteError(client, err)
 	}
 
 	return mfaDuoRead(d, meta)
@@ -104,14 +103,11 @@ func mfaDuoRead(d *schema.ResourceData, meta interface{}) error {
 
 	name := d.Get("name").(string)
 
-	resp, err := client.Logical().Read(mfaDuoPath(name))
-
-	if err != nil {
-		return fmt.Errorf("error reading from Vault: %s", err)
+	resp, err := mfaLegacyRead(d, client, mfaDuoPath(name))
+	if err != nil || resp == nil {
+		return err
 	}
 
-	log.Printf("[DEBUG] Read MFA Duo config %q", mfaDuoPath(name))
-
 	d.Set("mount_accessor", resp.Data["mount_accessor"])
 	d.Set("username_format", resp.Data["username_format"])
 	d.Set("api_hostname", resp.Data["api_hostname"])
@@ -156,5 +152,5 @@ func mfaDuoUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
 }
 
 func mfaDuoPath(name string) string {
-	return "sys/mfa/method/duo/" + strings.Trim(name, "/") + "/"
+	return mfaLegacyPath("duo", name)
 }
@@ -0,0 +1,113 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func mfaOktaResource() *schema.Resource {
+	return &schema.Resource{
+		Create: mfaOktaWrite,
+		Update: mfaOktaWrite,
+		Delete: mfaOktaDelete,
+		Read:   mfaOktaRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: mfaLegacyMappingSchema(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the MFA method.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"org_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the organization to be used in the Okta API.",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Okta API key.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set, will be used as the base domain for API requests, e.g. okta.com.",
+			},
+			"primary_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, the username will only match the primary email for the account.",
+			},
+		}),
+	}
+}
+
+func mfaOktaWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := mfaLegacyPath("okta", name)
+
+	data := map[string]interface{}{
+		"mount_accessor":  d.Get("mount_accessor"),
+		"username_format": d.Get("username_format"),
+		"org_name":        d.Get("org_name"),
+		"api_token":       d.Get("api_token"),
+		"base_url":        d.Get("base_url"),
+		"primary_email":   d.Get("primary_email"),
+	}
+
+	log.Printf("[DEBUG] Writing MFA Okta method %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return mfaLegacyWriteError(client, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA Okta method %q", path)
+
+	d.SetId(name)
+
+	return mfaOktaRead(d, meta)
+}
+
+func mfaOktaDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLegacyPath("okta", d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA Okta method %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Deleted MFA Okta method %q", path)
+
+	return nil
+}
+
+func mfaOktaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	resp, err := mfaLegacyRead(d, client, mfaLegacyPath("okta", d.Id()))
+	if err != nil || resp == nil {
+		return err
+	}
+
+	d.Set("name", d.Id())
+	// api_token can't be read back from Vault.
+	for _, k := range []string{"mount_accessor", "username_format", "org_name", "base_url", "primary_email"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on MFA Okta method %q: %s", k, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
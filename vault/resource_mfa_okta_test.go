@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMFAOktaBasic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	name := acctest.RandomWithPrefix("mfa-okta")
+	resourceName := "vault_mfa_okta.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testMFAOktaConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "org_name", "hashicorp"),
+					resource.TestCheckResourceAttr(resourceName, "base_url", "okta.com"),
+					resource.TestCheckResourceAttr(resourceName, "primary_email", "true"),
+					resource.TestCheckResourceAttr(resourceName, "username_format", "user@example.com"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func testMFAOktaConfig(name string) string {
+	userPassPath := acctest.RandomWithPrefix("userpass")
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = %q
+}
+
+resource "vault_mfa_okta" "test" {
+  name            = %q
+  mount_accessor  = vault_auth_backend.userpass.accessor
+  username_format = "user@example.com"
+  org_name        = "hashicorp"
+  api_token       = "token1"
+  base_url        = "okta.com"
+  primary_email   = true
+}
+`, userPassPath, name)
+}
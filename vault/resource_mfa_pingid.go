@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// mfaPingIDComputedFields are parsed by Vault from the settings file.
+var mfaPingIDComputedFields = []string{"use_signature", "idp_url", "admin_url", "authenticator_url", "org_alias"}
+
+func mfaPingIDResource() *schema.Resource {
+	return &schema.Resource{
+		Create: mfaPingIDWrite,
+		Update: mfaPingIDWrite,
+		Delete: mfaPingIDDelete,
+		Read:   mfaPingIDRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: mfaLegacyMappingSchema(map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the MFA method.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"settings_file_base64": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "A base64-encoded third-party settings file retrieved from PingID's configuration page.",
+			},
+			"use_signature": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether signatures are used, parsed from the settings file.",
+			},
+			"idp_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IDP URL, parsed from the settings file.",
+			},
+			"admin_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Admin URL, parsed from the settings file.",
+			},
+			"authenticator_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Authenticator URL, parsed from the settings file.",
+			},
+			"org_alias": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization alias, parsed from the settings file.",
+			},
+		}),
+	}
+}
+
+func mfaPingIDWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := mfaLegacyPath("pingid", name)
+
+	data := map[string]interface{}{
+		"mount_accessor":       d.Get("mount_accessor"),
+		"username_format":      d.Get("username_format"),
+		"settings_file_base64": d.Get("settings_file_base64"),
+	}
+
+	log.Printf("[DEBUG] Writing MFA PingID method %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return mfaLegacyWriteError(client, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA PingID method %q", path)
+
+	d.SetId(name)
+
+	return mfaPingIDRead(d, meta)
+}
+
+func mfaPingIDDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLegacyPath("pingid", d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA PingID method %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Deleted MFA PingID method %q", path)
+
+	return nil
+}
+
+func mfaPingIDRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	resp, err := mfaLegacyRead(d, client, mfaLegacyPath("pingid", d.Id()))
+	if err != nil || resp == nil {
+		return err
+	}
+
+	d.Set("name", d.Id())
+	// settings_file_base64 can't be read back from Vault.
+	for _, k := range append([]string{"mount_accessor", "username_format"}, mfaPingIDComputedFields...) {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on MFA PingID method %q: %s", k, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
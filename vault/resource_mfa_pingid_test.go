@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMFAPingIDBasic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	name := acctest.RandomWithPrefix("mfa-pingid")
+	resourceName := "vault_mfa_pingid.test"
+
+	settings := base64.StdEncoding.EncodeToString([]byte(`#Auto-Generated from PingOne, downloaded by id=[SSO] email=[user@example.com]
+#Fri Jul 01 00:00:00 UTC 2022
+use_base64_key=YWJjZGVmZ2hpamtsbW5vcHFyc3R1dnd4eXoxMjM0NTY3ODkwYWJjZGVmZ2hpamtsbW5vcA==
+use_signature=true
+token=lkjh
+idp_url=https\://idpxnyl3m.pingidentity.com/pingid
+org_alias=181459b0-9fb1-4938-8c86-473f6b7d6a1b
+admin_url=https\://idpxnyl3m.pingidentity.com/pingid
+authenticator_url=https\://authenticator.pingone.com/pingid/ppm
+`))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testMFAPingIDConfig(name, settings),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "use_signature", "true"),
+					resource.TestCheckResourceAttr(resourceName, "idp_url", "https://idpxnyl3m.pingidentity.com/pingid"),
+					resource.TestCheckResourceAttr(resourceName, "org_alias", "181459b0-9fb1-4938-8c86-473f6b7d6a1b"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_file_base64"},
+			},
+		},
+	})
+}
+
+func testMFAPingIDConfig(name, settings string) string {
+	userPassPath := acctest.RandomWithPrefix("userpass")
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = %q
+}
+
+resource "vault_mfa_pingid" "test" {
+  name                 = %q
+  mount_accessor       = vault_auth_backend.userpass.accessor
+  settings_file_base64 = %q
+}
+`, userPassPath, name, settings)
+}
@@ -0,0 +1,140 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func mfaTOTPResource() *schema.Resource {
+	return &schema.Resource{
+		Create: mfaTOTPWrite,
+		Update: mfaTOTPWrite,
+		Delete: mfaTOTPDelete,
+		Read:   mfaTOTPRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the MFA method.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				Description:  "Specifies the size in bytes of the generated key.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"qr_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				Description:  "The pixel size of the generated square QR code, 0 disables it.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SHA1",
+				Description:  "Specifies the hashing algorithm used to generate the TOTP code.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6,
+				Description:  "The number of digits in the generated TOTP token.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "The number of delay periods that are allowed when validating a TOTP token.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+		},
+	}
+}
+
+func mfaTOTPWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := mfaLegacyPath("totp", name)
+
+	data := map[string]interface{}{
+		"issuer":    d.Get("issuer"),
+		"period":    d.Get("period"),
+		"key_size":  d.Get("key_size"),
+		"qr_size":   d.Get("qr_size"),
+		"algorithm": d.Get("algorithm"),
+		"digits":    d.Get("digits"),
+		"skew":      d.Get("skew"),
+	}
+
+	log.Printf("[DEBUG] Writing MFA TOTP method %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return mfaLegacyWriteError(client, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA TOTP method %q", path)
+
+	d.SetId(name)
+
+	return mfaTOTPRead(d, meta)
+}
+
+func mfaTOTPDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLegacyPath("totp", d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA TOTP method %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting from Vault: %s", err)
+	}
+	log.Printf("[DEBUG] Deleted MFA TOTP method %q", path)
+
+	return nil
+}
+
+func mfaTOTPRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	resp, err := mfaLegacyRead(d, client, mfaLegacyPath("totp", d.Id()))
+	if err != nil || resp == nil {
+		return err
+	}
+
+	d.Set("name", d.Id())
+	for _, k := range []string{"issuer", "period", "key_size", "qr_size", "algorithm", "digits", "skew"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q on MFA TOTP method %q: %s", k, d.Id(), err)
+			}
+		}
+	}
+
+	return nil
+}
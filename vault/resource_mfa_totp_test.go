@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestMFATOTPBasic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	name := acctest.RandomWithPrefix("mfa-totp")
+	resourceName := "vault_mfa_totp.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testMFATOTPConfig(name, "SHA1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "hashicorp"),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+				),
+			},
+			{
+				Config: testMFATOTPConfig(name, "SHA256"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMFATOTPConfig(name, algorithm string) string {
+	return fmt.Sprintf(`
+resource "vault_mfa_totp" "test" {
+  name      = %q
+  issuer    = "hashicorp"
+  period    = 60
+  algorithm = %q
+  digits    = 8
+}
+`, name, algorithm)
+}
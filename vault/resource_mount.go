@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -100,6 +101,29 @@ func MountResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Enable the secrets engine to access Vault's external entropy source",
 			},
+
+			"passthrough_request_headers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of headers to allow and pass from the request to the plugin",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"allowed_response_headers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of headers to allow, allowing a plugin to include them in the response",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"listing_visibility": mountListingVisibilitySchema(),
+
+			"allowed_managed_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Managed keys the mount may use, by name or UUID. Requires Vault Enterprise 1.10 or later.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -111,8 +135,11 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 		Type:        d.Get("type").(string),
 		Description: d.Get("description").(string),
 		Config: api.MountConfigInput{
-			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
-			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+			DefaultLeaseTTL:           fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:               fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+			PassthroughRequestHeaders: util.ToStringArray(d.Get("passthrough_request_headers").([]interface{})),
+			AllowedResponseHeaders:    util.ToStringArray(d.Get("allowed_response_headers").([]interface{})),
+			ListingVisibility:         d.Get("listing_visibility").(string),
 		},
 		Local:                 d.Get("local").(bool),
 		Options:               opts(d),
@@ -127,9 +154,18 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 	if err := client.Sys().Mount(path, info); err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
+	kvMountCacheInvalidate(client, path)
 
 	d.SetId(path)
 
+	// The mount API of the Vault client doesn't support managed keys, they
+	// are tuned separately.
+	if v, ok := d.GetOk("allowed_managed_keys"); ok {
+		if err := mountTuneAllowedManagedKeys(client, path, v.(*schema.Set)); err != nil {
+			return err
+		}
+	}
+
 	return mountRead(d, meta)
 }
 
@@ -142,6 +178,17 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		Options:         opts(d),
 	}
 
+	if d.HasChange("passthrough_request_headers") {
+		config.PassthroughRequestHeaders = util.ToStringArray(d.Get("passthrough_request_headers").([]interface{}))
+	}
+	if d.HasChange("allowed_response_headers") {
+		config.AllowedResponseHeaders = util.ToStringArray(d.Get("allowed_response_headers").([]interface{}))
+	}
+
+	if d.HasChange("listing_visibility") {
+		config.ListingVisibility = mountListingVisibilityInput(d.Get("listing_visibility").(string))
+	}
+
 	if d.HasChange("description") {
 		description := fmt.Sprintf("%s", d.Get("description"))
 		config.Description = &description
@@ -159,6 +206,7 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("error remounting in Vault: %s", err)
 		}
 
+		kvMountCacheInvalidate(client, path)
 		d.SetId(newPath)
 		path = newPath
 	}
@@ -168,6 +216,14 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 	if err := client.Sys().TuneMount(path, config); err != nil {
 		return fmt.Errorf("error updating Vault: %s", err)
 	}
+	kvMountCacheInvalidate(client, path)
+
+	// An empty set is sent as well, to remove the managed keys.
+	if d.HasChange("allowed_managed_keys") {
+		if err := mountTuneAllowedManagedKeys(client, path, d.Get("allowed_managed_keys").(*schema.Set)); err != nil {
+			return err
+		}
+	}
 
 	return mountRead(d, meta)
 }
@@ -182,6 +238,7 @@ func mountDelete(d *schema.ResourceData, meta interface{}) error {
 	if err := client.Sys().Unmount(path); err != nil {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
+	kvMountCacheInvalidate(client, path)
 
 	return nil
 }
@@ -233,6 +290,40 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("options", mount.Options)
 	d.Set("seal_wrap", mount.SealWrap)
 	d.Set("external_entropy_access", mount.ExternalEntropyAccess)
+	d.Set("passthrough_request_headers", mount.Config.PassthroughRequestHeaders)
+	d.Set("allowed_response_headers", mount.Config.AllowedResponseHeaders)
+	d.Set("listing_visibility", mount.Config.ListingVisibility)
+
+	// Reading the tune endpoint needs an additional capability, so it is
+	// only read for mounts that configure managed keys.
+	if _, ok := d.GetOk("allowed_managed_keys"); ok {
+		tune, err := client.Logical().Read("sys/mounts/" + strings.Trim(path, "/") + "/tune")
+		if err != nil {
+			return fmt.Errorf("error reading tuning of mount %q: %s", path, err)
+		}
+		// Vault versions without managed keys omit them.
+		if tune != nil {
+			if v, ok := tune.Data["allowed_managed_keys"]; ok {
+				d.Set("allowed_managed_keys", v)
+			}
+		}
+	}
+
+	return nil
+}
+
+// mountTuneAllowedManagedKeys sets the managed keys the mount at path may use.
+func mountTuneAllowedManagedKeys(client *api.Client, path string, keys *schema.Set) error {
+	path = strings.Trim(path, "/")
+
+	log.Printf("[DEBUG] Tuning managed keys of mount %s in Vault", path)
+	data := map[string]interface{}{
+		"allowed_managed_keys": util.ToStringArray(keys.List()),
+	}
+	if _, err := client.Logical().Write("sys/mounts/"+path+"/tune", data); err != nil {
+		return fmt.Errorf("error tuning managed keys of mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Tuned managed keys of mount %s in Vault", path)
 
 	return nil
 }
@@ -2,10 +2,13 @@ package vault
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -170,6 +173,71 @@ func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	})
 }
 
+func TestResourceMount_ListingVisibility(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_ConfigListingVisibility(path, "unauth"),
+				Check:  testResourceMount_CheckListingVisibility(path, "unauth"),
+			},
+			{
+				Config: testResourceMount_ConfigListingVisibility(path, "hidden"),
+				Check:  testResourceMount_CheckListingVisibility(path, "hidden"),
+			},
+			{
+				Config: testResourceMount_ConfigListingVisibility(path, "unauth"),
+				Check:  testResourceMount_CheckListingVisibility(path, "unauth"),
+			},
+			{
+				// Unsetting the visibility hides the mount again.
+				Config: testResourceMount_InitialConfigExternalEntropyAccess(path),
+				Check:  testResourceMount_CheckListingVisibility(path, "hidden"),
+			},
+			{
+				ResourceName:      "vault_mount.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestMountTuneAllowedManagedKeys(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     []interface{}
+		expected string
+	}{
+		{"set", []interface{}{"hsm-key"}, `{"allowed_managed_keys":["hsm-key"]}`},
+		{"removed", nil, `{"allowed_managed_keys":[]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var path, body string
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				path = r.URL.Path
+				b, _ := ioutil.ReadAll(r.Body)
+				body = string(b)
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			if err := mountTuneAllowedManagedKeys(client, "/pki/", schema.NewSet(schema.HashString, tt.keys)); err != nil {
+				t.Fatal(err)
+			}
+			if path != "/v1/sys/mounts/pki/tune" {
+				t.Errorf("expected request to /v1/sys/mounts/pki/tune, got %q", path)
+			}
+			if body != tt.expected {
+				t.Errorf("expected body %s, got %s", tt.expected, body)
+			}
+		})
+	}
+}
+
 func testResourceMount_initialConfig(cfg mountConfig) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -540,6 +608,30 @@ resource "vault_mount" "test" {
 `, path, externalEntropyAccess)
 }
 
+func testResourceMount_ConfigListingVisibility(path, listingVisibility string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+	path = "%s"
+	type = "transit"
+	description = "Example mount for testing"
+	listing_visibility = "%s"
+}
+`, path, listingVisibility)
+}
+
+func testResourceMount_CheckListingVisibility(expectedPath, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		mount, err := findMount(expectedPath)
+		if err != nil {
+			return err
+		}
+		if mount.Config.ListingVisibility != expected {
+			return fmt.Errorf("expected listing_visibility %q, got %q", expected, mount.Config.ListingVisibility)
+		}
+		return nil
+	}
+}
+
 func findMount(path string) (*api.MountOutput, error) {
 	client := testProvider.Meta().(*api.Client)
 
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -11,46 +12,140 @@ import (
 
 func namespaceResource() *schema.Resource {
 	return &schema.Resource{
-		Create: namespaceWrite,
-		Update: namespaceWrite,
+		Create: namespaceCreate,
+		Update: namespaceUpdate,
 		Delete: namespaceDelete,
 		Read:   namespaceRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: namespaceImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"path": {
 				Type:         schema.TypeString,
 				Required:     true,
+				ForceNew:     true,
 				Description:  "Path of the namespace.",
 				ValidateFunc: validateNoTrailingSlash,
 			},
 
+			"create_parents": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "Create any missing intermediate namespaces in path. " +
+					"Only the namespaces created by this resource are removed on destroy.",
+			},
+
+			"created_parents": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Intermediate namespaces created because create_parents was set.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"namespace_id": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "ID of the namepsace.",
+				Description: "ID of the namespace.",
+			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata describing the namespace. Requires Vault 1.12 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 		},
 	}
 }
 
-func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
+func namespaceCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	path := d.Get("path").(string)
 
+	// Without an ID nothing is saved to state on failure, so the parents
+	// created so far are removed again rather than left behind.
+	var created []string
+	if d.IsNewResource() && d.Get("create_parents").(bool) {
+		var err error
+		created, err = namespaceCreateParents(client, path)
+		if err != nil {
+			namespaceDeleteParents(client, created)
+			return err
+		}
+		d.Set("created_parents", created)
+	}
+
+	var data map[string]interface{}
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data = map[string]interface{}{
+			"custom_metadata": v,
+		}
+	}
+
 	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write("sys/namespaces/"+path, nil)
+	_, err := client.Logical().Write("sys/namespaces/"+path, data)
 
 	if err != nil {
+		namespaceDeleteParents(client, created)
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
 	return namespaceRead(d, meta)
 }
 
+// namespaceUpdate updates the custom metadata of a namespace, which is the
+// only part of a namespace Vault allows to change.
+func namespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	if d.HasChange("custom_metadata") {
+		path := d.Get("path").(string)
+		o, n := d.GetChange("custom_metadata")
+
+		log.Printf("[DEBUG] Updating custom metadata of namespace %s in Vault", path)
+		if err := namespacePatchCustomMetadata(client, path, o.(map[string]interface{}), n.(map[string]interface{})); err != nil {
+			return fmt.Errorf("error updating custom metadata of namespace %q: %s", path, err)
+		}
+	}
+
+	return namespaceRead(d, meta)
+}
+
+// namespacePatchCustomMetadata replaces the custom metadata of a namespace.
+// Vault only supports changing it with a JSON merge patch, in which removed
+// keys are set to null.
+func namespacePatchCustomMetadata(client *api.Client, path string, o, n map[string]interface{}) error {
+	metadata := make(map[string]interface{}, len(o)+len(n))
+	for k := range o {
+		metadata[k] = nil
+	}
+	for k, v := range n {
+		metadata[k] = v
+	}
+
+	r := client.NewRequest(http.MethodPatch, "/v1/sys/namespaces/"+path)
+	if r.Headers == nil {
+		r.Headers = make(http.Header)
+	}
+	r.Headers.Set("Content-Type", "application/merge-patch+json")
+	if err := r.SetJSONBody(map[string]interface{}{"custom_metadata": metadata}); err != nil {
+		return err
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
+
 func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -64,9 +159,53 @@ func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
 
+	namespaceDeleteParents(client, expandStringSlice(d.Get("created_parents").([]interface{})))
+
 	return nil
 }
 
+// namespaceDeleteParents removes the parents created by namespaceCreateParents,
+// deepest first.
+func namespaceDeleteParents(client *api.Client, parents []string) {
+	for i := len(parents) - 1; i >= 0; i-- {
+		parent := parents[i]
+		log.Printf("[DEBUG] Deleting parent namespace %s from Vault", parent)
+		if _, err := client.Logical().Delete("sys/namespaces/" + parent); err != nil {
+			// The parent may have gained other children since it was
+			// created, in which case it is no longer ours to remove.
+			log.Printf("[WARN] Unable to delete parent namespace %s, leaving it in place: %s", parent, err)
+			break
+		}
+	}
+}
+
+// namespaceCreateParents creates every missing intermediate namespace of path,
+// returning the paths of those it created, outermost first.
+func namespaceCreateParents(client *api.Client, path string) ([]string, error) {
+	var created []string
+
+	parts := strings.Split(path, "/")
+	for i := 1; i < len(parts); i++ {
+		parent := strings.Join(parts[:i], "/")
+
+		resp, err := client.Logical().Read("sys/namespaces/" + parent)
+		if err != nil {
+			return created, fmt.Errorf("error reading parent namespace %q from Vault: %s", parent, err)
+		}
+		if resp != nil {
+			continue
+		}
+
+		log.Printf("[DEBUG] Creating parent namespace %s in Vault", parent)
+		if _, err := client.Logical().Write("sys/namespaces/"+parent, nil); err != nil {
+			return created, fmt.Errorf("error creating parent namespace %q in Vault: %s", parent, err)
+		}
+		created = append(created, parent)
+	}
+
+	return created, nil
+}
+
 func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -81,7 +220,7 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if resp == nil {
-		log.Printf("[WARN] Names %q not found, removing from state.", path)
+		log.Printf("[WARN] Namespace %q not found, removing from state.", path)
 		d.SetId("")
 		return nil
 	}
@@ -92,15 +231,32 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 	noTrailingSlashPath := strings.TrimSuffix(path, "/")
 	d.Set("path", noTrailingSlashPath)
 
+	// Older versions of Vault do not report custom_metadata.
+	if v, ok := resp.Data["custom_metadata"]; ok {
+		if err := d.Set("custom_metadata", v); err != nil {
+			return fmt.Errorf("error setting state key \"custom_metadata\": %s", err)
+		}
+	}
+
 	return nil
 }
 
+func namespaceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// create_parents only applies on creation and is missing from imported
+	// state, where its default would otherwise show as a change.
+	if err := d.Set("create_parents", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func upgradeNonPathdNamespaceID(d *schema.ResourceData) {
-	// Upgrade ID to path
+	// Upgrade ID to path. Vault reports the path with a trailing slash, which
+	// the path argument does not have.
 	id := d.Id()
 	oldID := d.Id()
 	path, ok := d.GetOk("path")
-	if id != path && ok {
+	if ok && strings.TrimSuffix(id, "/") != path.(string) {
 		log.Printf("[DEBUG] Upgrading old ID to path - %s to %s", id, path)
 		d.SetId(path.(string))
 		log.Printf("[DEBUG] Setting namespace_id to old ID - %s", oldID)
@@ -0,0 +1,120 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func namespaceAPILockResource() *schema.Resource {
+	return &schema.Resource{
+		Create: namespaceAPILockCreate,
+		Read:   namespaceAPILockRead,
+		Delete: namespaceAPILockDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path of the namespace to lock, relative to the provider's namespace.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+
+			"unlock_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Key required to unlock the namespace, only returned by Vault when it is locked.",
+			},
+		},
+	}
+}
+
+func namespaceAPILockCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Locking the API of namespace %s", path)
+	resp, err := client.Logical().Write("sys/namespaces/api-lock/lock/"+path, nil)
+	if err != nil {
+		return fmt.Errorf("error locking the API of namespace %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Locked the API of namespace %s", path)
+
+	d.SetId(path)
+
+	// The unlock key is only returned once, so it must be stored before
+	// anything else can fail.
+	if resp != nil {
+		if unlockKey, ok := resp.Data["unlock_key"].(string); ok {
+			d.Set("unlock_key", unlockKey)
+		}
+	}
+
+	return namespaceAPILockRead(d, meta)
+}
+
+func namespaceAPILockRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading namespace %s", path)
+	resp, err := client.Logical().Read("sys/namespaces/" + path)
+	if err != nil {
+		return fmt.Errorf("error reading namespace %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read namespace %s", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Namespace %q not found, removing its API lock from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// Vault versions that report the lock status allow detecting namespaces
+	// unlocked outside of Terraform.
+	if locked, ok := resp.Data["locked"].(bool); ok && !locked {
+		log.Printf("[WARN] Namespace %q is not locked, removing its API lock from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+
+	return nil
+}
+
+func namespaceAPILockDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// Without the unlock key, e.g. after an import, unlocking requires a
+	// token that is allowed to unlock the namespace without it.
+	var data map[string]interface{}
+	if unlockKey := d.Get("unlock_key").(string); unlockKey != "" {
+		data = map[string]interface{}{
+			"unlock_key": unlockKey,
+		}
+	}
+
+	log.Printf("[DEBUG] Unlocking the API of namespace %s", path)
+	if _, err := client.Logical().Write("sys/namespaces/api-lock/unlock/"+path, data); err != nil {
+		if util.Is404(err) {
+			return nil
+		}
+		return fmt.Errorf("error unlocking the API of namespace %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unlocked the API of namespace %s", path)
+
+	return nil
+}
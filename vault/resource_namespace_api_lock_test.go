@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestNamespaceAPILock(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+	resourceName := "vault_namespace_api_lock.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceAPILockConfig(namespacePath, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", namespacePath),
+					resource.TestCheckResourceAttrSet(resourceName, "unlock_key"),
+					testNamespaceAPILockCheckLocked(namespacePath, true),
+				),
+			},
+			{
+				Config: testNamespaceAPILockConfig(namespacePath, false),
+				Check:  testNamespaceAPILockCheckLocked(namespacePath, false),
+			},
+		},
+	})
+}
+
+// testNamespaceAPILockCheckLocked checks whether writes within the namespace
+// at path are rejected.
+func testNamespaceAPILockCheckLocked(path string, expectLocked bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testProvider.Meta().(*api.Client).Clone()
+		if err != nil {
+			return err
+		}
+		client.SetToken(testProvider.Meta().(*api.Client).Token())
+		client.SetNamespace(path)
+
+		_, err = client.Logical().Write("sys/policies/acl/tf-test-api-lock", map[string]interface{}{
+			"policy": `path "secret/*" { capabilities = ["read"] }`,
+		})
+		if expectLocked && err == nil {
+			return fmt.Errorf("expected namespace %q to be locked", path)
+		}
+		if !expectLocked && err != nil {
+			return fmt.Errorf("expected namespace %q to be unlocked: %s", path, err)
+		}
+		return nil
+	}
+}
+
+func testNamespaceAPILockConfig(path string, locked bool) string {
+	config := fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = %q
+}
+`, path)
+
+	if locked {
+		config += `
+resource "vault_namespace_api_lock" "test" {
+  path = vault_namespace.test.path
+}
+`
+	}
+
+	return config
+}
@@ -1,13 +1,17 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -44,6 +48,207 @@ func TestNamespace_basic(t *testing.T) {
 	})
 }
 
+func TestNamespace_createParents(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	root := acctest.RandomWithPrefix("test-namespace")
+	middle := root + "/" + acctest.RandomWithPrefix("middle")
+	leaf := middle + "/" + acctest.RandomWithPrefix("leaf")
+	resourceName := "vault_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			client := testProvider.Meta().(*api.Client)
+
+			// The pre-existing root namespace must survive the destroy.
+			resp, err := client.Logical().Read("sys/namespaces/" + root)
+			if err != nil {
+				return fmt.Errorf("error reading namespace %q: %s", root, err)
+			}
+			if resp == nil {
+				return fmt.Errorf("namespace %q was not created by the resource but was deleted", root)
+			}
+
+			for _, path := range []string{leaf, middle} {
+				if err := testNamespaceDestroy(path)(s); err != nil {
+					return err
+				}
+			}
+
+			_, err = client.Logical().Delete("sys/namespaces/" + root)
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if _, err := client.Logical().Write("sys/namespaces/"+root, nil); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testNamespaceConfigCreateParents(leaf),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", leaf),
+					resource.TestCheckResourceAttr(resourceName, "created_parents.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "created_parents.0", middle),
+				),
+			},
+		},
+	})
+}
+
+func TestNamespace_customMetadata(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+	resourceName := "vault_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `{ team = "vault", env = "dev" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", namespacePath+"/"),
+					resource.TestCheckResourceAttr(resourceName, "path", namespacePath),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.team", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.env", "dev"),
+				),
+			},
+			{
+				Config: testNamespaceConfigCustomMetadata(namespacePath, `{ team = "vault-ops" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.team", "vault-ops"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     namespacePath,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestNamespacePatchCustomMetadata(t *testing.T) {
+	var method, contentType string
+	var body map[string]interface{}
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		if r.URL.Path != "/v1/sys/namespaces/parent/child" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	o := map[string]interface{}{"team": "vault", "env": "dev"}
+	n := map[string]interface{}{"team": "vault-ops"}
+	if err := namespacePatchCustomMetadata(client, "parent/child", o, n); err != nil {
+		t.Fatal(err)
+	}
+
+	if method != http.MethodPatch {
+		t.Errorf("expected method %s, got %s", http.MethodPatch, method)
+	}
+	if contentType != "application/merge-patch+json" {
+		t.Errorf("expected merge patch content type, got %q", contentType)
+	}
+	expected := map[string]interface{}{
+		"custom_metadata": map[string]interface{}{"team": "vault-ops", "env": nil},
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestNamespaceCreateRollsBackParents(t *testing.T) {
+	var requests []string
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+		case r.URL.Path == "/v1/sys/namespaces/parent/child/test":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, namespaceResource().Schema, map[string]interface{}{
+		"path":           "parent/child/test",
+		"create_parents": true,
+	})
+	d.MarkNewResource()
+
+	if err := namespaceCreate(d, client); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	expected := []string{
+		"GET /v1/sys/namespaces/parent",
+		"PUT /v1/sys/namespaces/parent",
+		"GET /v1/sys/namespaces/parent/child",
+		"PUT /v1/sys/namespaces/parent/child",
+		"PUT /v1/sys/namespaces/parent/child/test",
+		"DELETE /v1/sys/namespaces/parent/child",
+		"DELETE /v1/sys/namespaces/parent",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("expected requests %v, got %v", expected, requests)
+	}
+}
+
+func TestUpgradeNonPathdNamespaceID(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expectedID  string
+		namespaceID string
+	}{
+		{"trailing slash", "ns1/", "ns1/", "abc12"},
+		{"path", "ns1", "ns1", "abc12"},
+		{"legacy ID", "abc12", "ns1", "abc12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := namespaceResource().TestResourceData()
+			d.SetId(tt.id)
+			d.Set("path", "ns1")
+			d.Set("namespace_id", "abc12")
+
+			upgradeNonPathdNamespaceID(d)
+
+			if d.Id() != tt.expectedID {
+				t.Errorf("expected ID %q, got %q", tt.expectedID, d.Id())
+			}
+			if got := d.Get("namespace_id").(string); got != tt.namespaceID {
+				t.Errorf("expected namespace_id %q, got %q", tt.namespaceID, got)
+			}
+		})
+	}
+}
+
 func testNamespaceCheckAttrs() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["vault_namespace.test"]
@@ -85,6 +290,24 @@ resource "vault_namespace" "test" {
 
 }
 
+func testNamespaceConfigCreateParents(path string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path           = %q
+  create_parents = true
+}
+`, path)
+}
+
+func testNamespaceConfigCustomMetadata(path, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path            = %q
+  custom_metadata = %s
+}
+`, path, metadata)
+}
+
 func testNestedNamespaceConfig(parentPath, childPath string) string {
 	return fmt.Sprintf(`
 provider "vault" {
@@ -252,6 +252,7 @@ func oktaAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Writing auth %s to Vault", authType)
 
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
 		Type:        authType,
 		Description: desc,
@@ -273,6 +274,7 @@ func oktaAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
+	authMountAccessorCacheInvalidate(client)
 	err := client.Sys().DisableAuth(path)
 
 	if err != nil {
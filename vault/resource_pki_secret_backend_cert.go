@@ -165,30 +165,25 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 
 	commonName := d.Get("common_name").(string)
 
-	iAltNames := d.Get("alt_names").([]interface{})
-	altNames := make([]string, 0, len(iAltNames))
-	for _, iAltName := range iAltNames {
-		altNames = append(altNames, iAltName.(string))
-	}
+	data := pkiSecretBackendCertRequestData(d)
 
-	iIPSans := d.Get("ip_sans").([]interface{})
-	ipSans := make([]string, 0, len(iIPSans))
-	for _, iIpSan := range iIPSans {
-		ipSans = append(ipSans, iIpSan.(string))
+	log.Printf("[DEBUG] Creating certificate %s by %s on PKI secret backend %q", commonName, name, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating certificate %s by %s for PKI secret backend %q: %s", commonName, name,
+			backend, err)
 	}
+	log.Printf("[DEBUG] Created certificate %s by %s on PKI secret backend %q", commonName, name, backend)
 
-	iURISans := d.Get("uri_sans").([]interface{})
-	uriSans := make([]string, 0, len(iURISans))
-	for _, iUriSan := range iURISans {
-		uriSans = append(uriSans, iUriSan.(string))
-	}
+	pkiSecretBackendCertSetResponse(d, resp)
 
-	iOtherSans := d.Get("other_sans").([]interface{})
-	otherSans := make([]string, 0, len(iOtherSans))
-	for _, iOtherSan := range iOtherSans {
-		otherSans = append(otherSans, iOtherSan.(string))
-	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", backend, name, commonName))
+	return pkiSecretBackendCertRead(d, meta)
+}
 
+// pkiSecretBackendCertRequestData builds the request for the issue endpoint
+// from the arguments shared by the certificate resource and data source.
+func pkiSecretBackendCertRequestData(d *schema.ResourceData) map[string]interface{} {
 	data := map[string]interface{}{
 		"common_name":          d.Get("common_name").(string),
 		"ttl":                  d.Get("ttl").(string),
@@ -197,30 +192,18 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
 	}
 
-	if len(altNames) > 0 {
-		data["alt_names"] = strings.Join(altNames, ",")
-	}
-
-	if len(ipSans) > 0 {
-		data["ip_sans"] = strings.Join(ipSans, ",")
-	}
-
-	if len(uriSans) > 0 {
-		data["uri_sans"] = strings.Join(uriSans, ",")
-	}
-
-	if len(otherSans) > 0 {
-		data["other_sans"] = strings.Join(otherSans, ",")
+	for _, k := range []string{"alt_names", "ip_sans", "uri_sans", "other_sans"} {
+		if v := convertIntoSliceOfString(d.Get(k)); len(v) > 0 {
+			data[k] = strings.Join(v, ",")
+		}
 	}
 
-	log.Printf("[DEBUG] Creating certificate %s by %s on PKI secret backend %q", commonName, name, backend)
-	resp, err := client.Logical().Write(path, data)
-	if err != nil {
-		return fmt.Errorf("error creating certificate %s by %s for PKI secret backend %q: %s", commonName, name,
-			backend, err)
-	}
-	log.Printf("[DEBUG] Created certificate %s by %s on PKI secret backend %q", commonName, name, backend)
+	return data
+}
 
+// pkiSecretBackendCertSetResponse sets the attributes computed from the
+// response of the issue endpoint.
+func pkiSecretBackendCertSetResponse(d *schema.ResourceData, resp *api.Secret) {
 	caChain := resp.Data["ca_chain"]
 	if caChain != nil {
 		d.Set("ca_chain", strings.Join(convertIntoSliceOfString(caChain)[:], "\n"))
@@ -232,9 +215,6 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 	d.Set("private_key_type", resp.Data["private_key_type"])
 	d.Set("serial_number", resp.Data["serial_number"])
 	d.Set("expiration", resp.Data["expiration"])
-
-	d.SetId(fmt.Sprintf("%s/%s/%s", backend, name, commonName))
-	return pkiSecretBackendCertRead(d, meta)
 }
 
 func pkiSecretBackendCertNeedsRenewed(autoRenew bool, expiration int, minSecRemaining int) bool {
@@ -257,11 +237,11 @@ func pkiSecretBackendCertDiff(_ context.Context, d *schema.ResourceDiff, meta in
 	}
 	if pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), d.Get("expiration").(int), minSeconds) {
 		log.Printf("[DEBUG] certificate %q is due for renewal", d.Id())
-		if err := d.SetNewComputed("certificate"); err != nil {
-			return err
-		}
-		if err := d.SetNewComputed("private_key"); err != nil {
-			return err
+		// Everything returned by the issue endpoint changes on renewal.
+		for _, k := range []string{"certificate", "issuing_ca", "ca_chain", "private_key", "private_key_type", "serial_number", "expiration"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
 		}
 		return nil
 	}
@@ -143,6 +143,7 @@ resource "vault_pki_secret_backend_cert" "test" {
 
 func TestPkiSecretBackendCert_renew(t *testing.T) {
 	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	var serialNumber string
 
 	resource.Test(t, resource.TestCase{
 		Providers:    testProviders,
@@ -157,6 +158,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "min_seconds_remaining", "3595"),
 					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "expiration"),
+					testPkiSecretBackendCertSerialNumber("vault_pki_secret_backend_cert.test", &serialNumber, false),
 				),
 			},
 			{
@@ -183,6 +185,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "min_seconds_remaining", "3595"),
 					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "expiration"),
+					testPkiSecretBackendCertSerialNumber("vault_pki_secret_backend_cert.test", &serialNumber, true),
 				),
 			},
 		},
@@ -263,3 +266,48 @@ func testPkiSecretBackendCertWaitUntilRenewal(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+// testPkiSecretBackendCertSerialNumber stores the serial number of the
+// certificate, checking that it differs from the stored one if changed is set.
+func testPkiSecretBackendCertSerialNumber(n string, serialNumber *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		current := rs.Primary.Attributes["serial_number"]
+		if current == "" {
+			return fmt.Errorf("No serial_number is set")
+		}
+		if changed && current == *serialNumber {
+			return fmt.Errorf("expected serial_number to change on renewal, still %q", current)
+		}
+		*serialNumber = current
+
+		return nil
+	}
+}
+
+func TestPkiSecretBackendCertNeedsRenewed(t *testing.T) {
+	now := int(time.Now().Unix())
+	tests := []struct {
+		name            string
+		autoRenew       bool
+		expiration      int
+		minSecRemaining int
+		want            bool
+	}{
+		{"disabled", false, now + 10, 60, false},
+		{"within threshold", true, now + 10, 60, true},
+		{"outside threshold", true, now + 3600, 60, false},
+		{"expired", true, now - 10, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pkiSecretBackendCertNeedsRenewed(tt.autoRenew, tt.expiration, tt.minSecRemaining); got != tt.want {
+				t.Errorf("pkiSecretBackendCertNeedsRenewed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
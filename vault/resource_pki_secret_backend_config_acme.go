@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendConfigAcmeFields = []string{
+	"enabled",
+	"allowed_issuers",
+	"allowed_roles",
+	"default_directory_policy",
+	"dns_resolver",
+	"eab_policy",
+}
+
+func pkiSecretBackendConfigAcmeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAcmeWrite,
+		Read:   pkiSecretBackendConfigAcmeRead,
+		Update: pkiSecretBackendConfigAcmeWrite,
+		Delete: pkiSecretBackendConfigAcmeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether ACME is enabled on the backend.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issuers allowed to be used by ACME. Defaults to all issuers.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Roles allowed to be used by ACME. Defaults to all roles.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"default_directory_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: "Policy of the default ACME directory: forbid, sign-verbatim, " +
+					"role:<role_name> or external-policy.",
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "DNS resolver, in host:port form, used to validate dns-01 challenges.",
+			},
+			"eab_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "External Account Binding policy: not-required, new-account-required or always-required.",
+				ValidateFunc: validation.StringInSlice([]string{"not-required", "new-account-required", "always-required"}, false),
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigAcmeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigAcmePath(backend)
+
+	data := map[string]interface{}{
+		"enabled":      d.Get("enabled").(bool),
+		"dns_resolver": d.Get("dns_resolver").(string),
+	}
+	for _, k := range []string{"allowed_issuers", "allowed_roles", "default_directory_policy", "eab_policy"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing ACME config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote ACME config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigAcmeRead(d, meta)
+}
+
+func pkiSecretBackendConfigAcmeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/acme")
+
+	log.Printf("[DEBUG] Reading ACME config from PKI secret backend %q", backend)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading ACME config from PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read ACME config from PKI secret backend %q", backend)
+
+	if config == nil {
+		log.Printf("[WARN] ACME config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendConfigAcmeFields {
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q for ACME config %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAcmeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// The ACME config cannot be removed, so disable ACME instead.
+	log.Printf("[DEBUG] Disabling ACME on %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	}); err != nil {
+		return fmt.Errorf("error disabling ACME on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled ACME on %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigAcmePath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/acme"
+}
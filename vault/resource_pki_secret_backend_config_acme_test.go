@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendConfigAcme_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-acme")
+	resourceName := "vault_pki_secret_backend_config_acme.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendConfigAcmeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAcmeConfig(backend, true, "not-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "allowed_response_headers.#", "5"),
+					resource.TestCheckResourceAttr("vault_mount.test", "passthrough_request_headers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "default_directory_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "not-required"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAcmeConfig(backend, false, "always-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "always-required"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAcmeDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mount" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPkiSecretBackendConfigAcmeConfig(backend string, enabled bool, eabPolicy string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+
+  passthrough_request_headers = ["If-Modified-Since"]
+  allowed_response_headers    = ["Last-Modified", "Location", "Replay-Nonce", "Link", "ETag"]
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = vault_mount.test.path
+  path    = "http://127.0.0.1:8200/v1/${vault_mount.test.path}"
+}
+
+resource "vault_pki_secret_backend_config_acme" "test" {
+  backend    = vault_pki_secret_backend_config_cluster.test.backend
+  enabled    = %t
+  eab_policy = "%s"
+}
+`, backend, enabled, eabPolicy)
+}
@@ -29,6 +29,22 @@ func pkiSecretBackendConfigCAResource() *schema.Resource {
 				ForceNew:    true,
 				Sensitive:   true,
 			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the issuers imported from the bundle. Only reported by Vault 1.11 and later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the keys imported from the bundle. Only reported by Vault 1.11 and later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -45,12 +61,31 @@ func pkiSecretBackendConfigCACreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	log.Printf("[DEBUG] Creating CA config on PKI secret backend %q", backend)
-	_, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating CA config for PKI secret backend %q: %s", backend, err)
 	}
 	log.Printf("[DEBUG] Created CA config on PKI secret backend %q", backend)
 
+	// Vault versions with multiple issuer support report what was imported,
+	// older versions return no response at all. Issuers and keys already
+	// present in the mount are not reported as imported.
+	var importedIssuers, importedKeys []string
+	if resp != nil {
+		if v, ok := resp.Data["imported_issuers"]; ok && v != nil {
+			importedIssuers = convertIntoSliceOfString(v)
+		}
+		if v, ok := resp.Data["imported_keys"]; ok && v != nil {
+			importedKeys = convertIntoSliceOfString(v)
+		}
+	}
+	if err := d.Set("imported_issuers", importedIssuers); err != nil {
+		return err
+	}
+	if err := d.Set("imported_keys", importedKeys); err != nil {
+		return err
+	}
+
 	d.SetId(backend)
 	return pkiSecretBackendConfigCARead(d, meta)
 }
@@ -30,6 +30,59 @@ func TestPkiSecretBackendConfigCA_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendConfigCA_imported(t *testing.T) {
+	path := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// imported_issuers and imported_keys are reported by Vault 1.11 and later.
+			testAccSkipIfVaultVersionBefore(t, 1, 11)
+		},
+		CheckDestroy: testPkiSecretBackendConfigCADestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigCAConfig_basic(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_ca.test", "backend", path),
+					// The bundle holds a single certificate and its key.
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_ca.test", "imported_issuers.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_ca.test", "imported_keys.#", "1"),
+					testPkiSecretBackendConfigCACheckImported("vault_pki_secret_backend_config_ca.test"),
+				),
+			},
+		},
+	})
+}
+
+// testPkiSecretBackendConfigCACheckImported verifies that the imported issuer
+// exists in the mount and is backed by the imported key.
+func testPkiSecretBackendConfigCACheckImported(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		backend := rs.Primary.Attributes["backend"]
+		issuerID := rs.Primary.Attributes["imported_issuers.0"]
+		keyID := rs.Primary.Attributes["imported_keys.0"]
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Read(fmt.Sprintf("%s/issuer/%s", backend, issuerID))
+		if err != nil {
+			return fmt.Errorf("error reading issuer %q: %s", issuerID, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("issuer %q not found in %q", issuerID, backend)
+		}
+		if got := resp.Data["key_id"]; got != keyID {
+			return fmt.Errorf("expected issuer %q to use key %q, got %v", issuerID, keyID, got)
+		}
+		return nil
+	}
+}
+
 func testPkiSecretBackendConfigCADestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendConfigClusterFields = []string{
+	"path",
+	"aia_path",
+}
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterWrite,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterWrite,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Canonical URL to this cluster's backend, used for ACME and other protocols.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"aia_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Non-TLS URL to this cluster's backend, used in AIA URL templates.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendConfigClusterFields {
+		data[k] = d.Get(k).(string)
+	}
+
+	log.Printf("[DEBUG] Writing cluster config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cluster config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/cluster")
+
+	log.Printf("[DEBUG] Reading cluster config from PKI secret backend %q", backend)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config from PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read cluster config from PKI secret backend %q", backend)
+
+	if config == nil {
+		log.Printf("[WARN] Cluster config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendConfigClusterFields {
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q for cluster config %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestPkiSecretBackendConfigCluster_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki-cluster")
+	resourceName := "vault_pki_secret_backend_config_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendConfigAcmeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendConfigClusterConfig(backend, "vault.example.com", ""),
+				ExpectError: regexp.MustCompile(`expected "path" to have a host`),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "https://vault.example.com:8200/v1/"+backend, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "path", "https://vault.example.com:8200/v1/"+backend),
+					resource.TestCheckResourceAttr(resourceName, "aia_path", ""),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "https://vault.example.com:8200/v1/"+backend, "http://vault.example.com:8200/v1/"+backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "aia_path", "http://vault.example.com:8200/v1/"+backend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigClusterConfig(backend, path, aiaPath string) string {
+	if aiaPath != "" {
+		aiaPath = fmt.Sprintf("aia_path = %q", aiaPath)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = vault_mount.test.path
+  path    = "%s"
+  %s
+}
+`, backend, path, aiaPath)
+}
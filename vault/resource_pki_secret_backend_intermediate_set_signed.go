@@ -1,11 +1,17 @@
 package vault
 
 import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -13,8 +19,11 @@ func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendIntermediateSetSignedCreate,
 		Read:   pkiSecretBackendIntermediateSetSignedRead,
+		Update: pkiSecretBackendIntermediateSetSignedRead,
 		Delete: pkiSecretBackendIntermediateSetSignedDelete,
 
+		CustomizeDiff: pkiSecretBackendIntermediateSetSignedDiff,
+
 		Schema: map[string]*schema.Schema{
 			"backend": {
 				Type:        schema.TypeString,
@@ -28,6 +37,17 @@ func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
 				Description: "The certificate.",
 				ForceNew:    true,
 			},
+			"min_seconds_remaining": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Replace the resource when the certificate expires within this number of seconds. Disabled if unset.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expiration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The expiration date of the certificate in unix epoch format.",
+			},
 		},
 	}
 }
@@ -55,7 +75,79 @@ func pkiSecretBackendIntermediateSetSignedCreate(d *schema.ResourceData, meta in
 }
 
 func pkiSecretBackendIntermediateSetSignedRead(d *schema.ResourceData, meta interface{}) error {
-	return nil
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := strings.Trim(backend, "/") + "/cert/ca"
+
+	log.Printf("[DEBUG] Reading CA certificate from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading CA certificate from PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read CA certificate from PKI secret backend %q", backend)
+
+	configured, err := pkiSecretBackendLeafCertificate(d.Get("certificate").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing certificate of %q: %s", d.Id(), err)
+	}
+
+	var current string
+	if resp != nil {
+		current, _ = resp.Data["certificate"].(string)
+	}
+	if current == "" {
+		log.Printf("[WARN] PKI secret backend %q has no CA certificate", backend)
+		return d.Set("expiration", configured.NotAfter.Unix())
+	}
+
+	cert, err := pkiSecretBackendLeafCertificate(current)
+	if err != nil {
+		return fmt.Errorf("error parsing CA certificate of PKI secret backend %q: %s", backend, err)
+	}
+
+	// The configured chain is kept as is unless Vault uses another
+	// certificate, in which case the difference replaces the resource.
+	if !bytes.Equal(configured.Raw, cert.Raw) {
+		log.Printf("[WARN] PKI secret backend %q uses a different CA certificate than %q", backend, d.Id())
+		if err := d.Set("certificate", current); err != nil {
+			return err
+		}
+	}
+
+	return d.Set("expiration", cert.NotAfter.Unix())
+}
+
+// pkiSecretBackendIntermediateSetSignedDiff replaces the resource when the
+// configured certificate expires within min_seconds_remaining. Setting the same
+// certificate again does not extend its validity, so the replacement is planned
+// until a newly signed certificate is configured.
+func pkiSecretBackendIntermediateSetSignedDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	minSeconds := d.Get("min_seconds_remaining").(int)
+	if d.Id() == "" || minSeconds == 0 || !d.NewValueKnown("certificate") {
+		return nil
+	}
+	// A changed certificate replaces the resource anyway.
+	if d.HasChange("certificate") {
+		return nil
+	}
+
+	cert, err := pkiSecretBackendLeafCertificate(d.Get("certificate").(string))
+	if err != nil {
+		return fmt.Errorf("error parsing certificate: %s", err)
+	}
+
+	if !time.Now().After(cert.NotAfter.Add(-time.Duration(minSeconds) * time.Second)) {
+		return nil
+	}
+
+	log.Printf("[WARN] Intermediate certificate %q of PKI secret backend %q expires at %s, "+
+		"configure a newly signed certificate to replace it", cert.Subject.CommonName,
+		d.Get("backend").(string), cert.NotAfter.Format(time.RFC3339))
+	if err := d.SetNewComputed("expiration"); err != nil {
+		return err
+	}
+	return d.ForceNew("expiration")
 }
 
 func pkiSecretBackendIntermediateSetSignedDelete(d *schema.ResourceData, meta interface{}) error {
@@ -65,3 +157,44 @@ func pkiSecretBackendIntermediateSetSignedDelete(d *schema.ResourceData, meta in
 func pkiSecretBackendIntermediateSetSignedCreatePath(backend string) string {
 	return strings.Trim(backend, "/") + "/intermediate/set-signed"
 }
+
+// pkiSecretBackendLeafCertificate returns the leaf of a PEM encoded
+// certificate chain, which is the certificate that did not issue any other
+// certificate of the chain, regardless of the order of the chain.
+func pkiSecretBackendLeafCertificate(pemData string) (*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	rest := []byte(pemData)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	for _, cert := range certs {
+		issuer := false
+		for _, other := range certs {
+			if other != cert && bytes.Equal(other.RawIssuer, cert.RawSubject) {
+				issuer = true
+				break
+			}
+		}
+		if !issuer {
+			return cert, nil
+		}
+	}
+
+	return certs[0], nil
+}
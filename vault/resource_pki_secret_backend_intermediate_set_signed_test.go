@@ -1,9 +1,19 @@
 package vault
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -31,6 +41,179 @@ func TestPkiSecretBackendIntermediateSetSigned_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendLeafCertificate(t *testing.T) {
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(48 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, &rootKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediateTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	intermediateDER, err := x509.CreateCertificate(rand.Reader, intermediateTemplate, rootTemplate, &intermediateKey.PublicKey, rootKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER}))
+	intermediatePEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: intermediateDER}))
+
+	tests := map[string]string{
+		"single":       intermediatePEM,
+		"leaf first":   intermediatePEM + rootPEM,
+		"leaf last":    rootPEM + intermediatePEM,
+		"with newline": "\n" + intermediatePEM + "\n" + rootPEM,
+	}
+	for name, chain := range tests {
+		t.Run(name, func(t *testing.T) {
+			cert, err := pkiSecretBackendLeafCertificate(chain)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if cert.Subject.CommonName != "intermediate" {
+				t.Fatalf("expected the intermediate certificate, got %q", cert.Subject.CommonName)
+			}
+		})
+	}
+
+	if _, err := pkiSecretBackendLeafCertificate("not a certificate"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestPkiSecretBackendIntermediateSetSignedRead(t *testing.T) {
+	configured := testPkiSecretBackendSelfSignedCertificate(t, "configured")
+	other := testPkiSecretBackendSelfSignedCertificate(t, "other")
+
+	tests := []struct {
+		name     string
+		current  string
+		expected string
+	}{
+		{"unchanged", configured, configured},
+		{"replaced", other, other},
+		{"missing", "", configured},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/pki-intermediate/cert/ca" {
+					t.Errorf("unexpected request to %q", r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprintf(w, `{"data":{"certificate":%q}}`, tt.current)
+			})
+
+			d := pkiSecretBackendIntermediateSetSignedResource().TestResourceData()
+			d.SetId("pki-intermediate/intermediate/set-signed")
+			d.Set("backend", "pki-intermediate")
+			d.Set("certificate", configured)
+
+			if err := pkiSecretBackendIntermediateSetSignedRead(d, client); err != nil {
+				t.Fatal(err)
+			}
+			if d.Id() == "" {
+				t.Fatal("expected the resource to remain in state")
+			}
+			if got := d.Get("certificate").(string); got != tt.expected {
+				t.Fatalf("expected certificate %q, got %q", tt.expected, got)
+			}
+			if d.Get("expiration").(int) == 0 {
+				t.Fatal("expected the expiration to be set")
+			}
+		})
+	}
+}
+
+func TestPkiSecretBackendIntermediateSetSignedDiff(t *testing.T) {
+	// The certificate expires in an hour.
+	certificate := testPkiSecretBackendSelfSignedCertificate(t, "expiring")
+
+	tests := []struct {
+		name        string
+		minSeconds  int
+		requiresNew bool
+	}{
+		{"disabled", 0, false},
+		{"outside the window", 60, false},
+		{"inside the window", 7200, true},
+	}
+
+	r := pkiSecretBackendIntermediateSetSignedResource()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "pki-intermediate/intermediate/set-signed",
+				Attributes: map[string]string{
+					"id":                    "pki-intermediate/intermediate/set-signed",
+					"backend":               "pki-intermediate",
+					"certificate":           certificate,
+					"min_seconds_remaining": strconv.Itoa(tt.minSeconds),
+					"expiration":            strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+				},
+			}
+			c := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"backend":               "pki-intermediate",
+				"certificate":           certificate,
+				"min_seconds_remaining": tt.minSeconds,
+			})
+
+			diff, err := r.Diff(context.Background(), state, c, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tt.requiresNew {
+				t.Fatalf("expected RequiresNew %t, got %t", tt.requiresNew, got)
+			}
+		})
+	}
+}
+
+func testPkiSecretBackendSelfSignedCertificate(t *testing.T, commonName string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
 func testPkiSecretBackendIntermediateSetSignedDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
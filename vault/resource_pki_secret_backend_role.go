@@ -312,6 +312,30 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description:  "Specifies the duration by which to backdate the NotBefore property.",
 				ValidateFunc: validateDuration,
 			},
+			"issuer_ref": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Reference to the issuer used to sign requests serviced by this role.",
+				Default:      "default",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"cn_validations": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Validations to run on the Common Name field of the certificate, or disabled to skip them.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"email", "hostname", "disabled"}, false),
+				},
+			},
+			"serial_number_source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Source of the Subject serial number, either json-csr or json.",
+				ValidateFunc: validation.StringInSlice([]string{"json-csr", "json"}, false),
+			},
 		},
 	}
 }
@@ -383,6 +407,7 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"issuer_ref":                         d.Get("issuer_ref"),
 	}
 
 	if len(allowedDomains) > 0 {
@@ -401,6 +426,14 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
+	if cnValidations := expandStringSlice(d.Get("cn_validations").([]interface{})); len(cnValidations) > 0 {
+		data["cn_validations"] = cnValidations
+	}
+
+	if v, ok := d.GetOk("serial_number_source"); ok {
+		data["serial_number_source"] = v
+	}
+
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -512,6 +545,23 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("basic_constraints_valid_for_non_ca", secret.Data["basic_constraints_valid_for_non_ca"])
 	d.Set("not_before_duration", notBeforeDuration)
 
+	// Vault versions before 1.11 don't support CN validations, and versions
+	// before 1.17 don't support choosing the serial number source.
+	if v, ok := secret.Data["cn_validations"]; ok {
+		d.Set("cn_validations", v)
+	}
+	if v, ok := secret.Data["serial_number_source"]; ok {
+		d.Set("serial_number_source", v)
+	}
+
+	// Vault versions without multiple issuer support don't return
+	// issuer_ref, and always sign from the mount's only issuer.
+	if v, ok := secret.Data["issuer_ref"]; ok && v != "" {
+		d.Set("issuer_ref", v)
+	} else {
+		d.Set("issuer_ref", "default")
+	}
+
 	return nil
 }
 
@@ -578,6 +628,7 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		"require_cn":                         d.Get("require_cn"),
 		"basic_constraints_valid_for_non_ca": d.Get("basic_constraints_valid_for_non_ca"),
 		"not_before_duration":                d.Get("not_before_duration"),
+		"issuer_ref":                         d.Get("issuer_ref"),
 	}
 
 	if len(allowedDomains) > 0 {
@@ -596,6 +647,14 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
+	if cnValidations := expandStringSlice(d.Get("cn_validations").([]interface{})); len(cnValidations) > 0 {
+		data["cn_validations"] = cnValidations
+	}
+
+	if v, ok := d.GetOk("serial_number_source"); ok {
+		data["serial_number_source"] = v
+	}
+
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
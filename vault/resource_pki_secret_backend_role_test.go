@@ -1,11 +1,16 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -65,6 +70,7 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.0", "1.2.3.4"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "basic_constraints_valid_for_non_ca", "false"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "not_before_duration", "45m"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "issuer_ref", "default"),
 				),
 			},
 			{
@@ -114,12 +120,57 @@ func TestPkiSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.0", "1.2.3.4"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "basic_constraints_valid_for_non_ca", "false"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "not_before_duration", "45m"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "issuer_ref", "default"),
 				),
 			},
 		},
 	})
 }
 
+func TestPkiSecretBackendRole_cnValidations(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	name := acctest.RandomWithPrefix("role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_cnValidations(name, backend, `["email", "hostname"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "cn_validations.#", "2"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "cn_validations.0", "email"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "cn_validations.1", "hostname"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_cnValidations(name, backend, `["disabled"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "cn_validations.#", "1"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "cn_validations.0", "disabled"),
+				),
+			},
+			{
+				Config:      testPkiSecretBackendRoleConfig_cnValidations(name, backend, `["uri"]`),
+				ExpectError: regexp.MustCompile(`expected cn_validations.0 to be one of \[email hostname disabled\]`),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendRoleConfig_cnValidations(name, path, cnValidations string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "pki" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend        = vault_pki_secret_backend.pki.path
+  name           = "%s"
+  cn_validations = %s
+}`, path, name, cnValidations)
+}
+
 func testPkiSecretBackendRoleConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_pki_secret_backend" "pki" {
@@ -234,3 +285,37 @@ func testPkiSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestPkiSecretBackendRoleUpdateSendsConfigured(t *testing.T) {
+	var written map[string]interface{}
+	client := testHTTPClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			if err := json.NewDecoder(r.Body).Decode(&written); err != nil {
+				t.Errorf("error decoding request: %s", err)
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// Neither field changes, they are sent anyway like every other field.
+	d := schema.TestResourceDataRaw(t, pkiSecretBackendRoleResource().Schema, map[string]interface{}{
+		"backend":              "pki",
+		"name":                 "test",
+		"cn_validations":       []interface{}{"email", "hostname"},
+		"serial_number_source": "json",
+	})
+	d.SetId("pki/roles/test")
+
+	if err := pkiSecretBackendRoleUpdate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := written["cn_validations"], []interface{}{"email", "hostname"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected cn_validations %v, got %v", want, got)
+	}
+	if got, want := written["serial_number_source"], "json"; got != want {
+		t.Errorf("expected serial_number_source %q, got %v", want, got)
+	}
+}
@@ -1,19 +1,25 @@
 package vault
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"log"
 
+	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
 
 func policyResource() *schema.Resource {
 	return &schema.Resource{
-		Create: policyWrite,
-		Update: policyWrite,
-		Delete: policyDelete,
-		Read:   policyRead,
+		Create:        policyWrite,
+		Update:        policyWrite,
+		Delete:        policyDelete,
+		Read:          policyRead,
+		CustomizeDiff: policyDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -27,20 +33,88 @@ func policyResource() *schema.Resource {
 			},
 
 			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The policy document",
+				ExactlyOneOf: []string{"policy", "source_file"},
+			},
+
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Path to a file containing the policy document",
+				ExactlyOneOf: []string{"policy", "source_file"},
+			},
+
+			"source_file_hash": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The policy document",
+				Computed:    true,
+				Description: "SHA256 hash of the policy document, set when source_file is used",
 			},
 		},
 	}
 }
 
+// policyDiff plans an update whenever the contents of source_file no longer
+// match the policy stored in Vault.
+func policyDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	sourceFile := d.Get("source_file").(string)
+	if sourceFile == "" {
+		return nil
+	}
+
+	policy, err := policyReadSourceFile(sourceFile)
+	if err != nil {
+		return err
+	}
+
+	if hash := policyHash(policy); hash != d.Get("source_file_hash").(string) {
+		log.Printf("[DEBUG] Policy source file %q changed", sourceFile)
+		if err := d.SetNew("source_file_hash", hash); err != nil {
+			return err
+		}
+		if err := d.SetNew("policy", policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func policyReadSourceFile(path string) (string, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading policy source file %q: %s", path, err)
+	}
+
+	policy := string(b)
+	if _, err := hcl.Parse(policy); err != nil {
+		return "", fmt.Errorf("policy source file %q is not valid HCL: %s", path, err)
+	}
+
+	return policy, nil
+}
+
+func policyHash(policy string) string {
+	sum := sha256.Sum256([]byte(policy))
+	return hex.EncodeToString(sum[:])
+}
+
 func policyWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
 	name := d.Get("name").(string)
 	policy := d.Get("policy").(string)
 
+	if sourceFile := d.Get("source_file").(string); sourceFile != "" {
+		var err error
+		policy, err = policyReadSourceFile(sourceFile)
+		if err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Writing policy %s to Vault", name)
 	err := client.Sys().PutPolicy(name, policy)
 
@@ -82,5 +156,13 @@ func policyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("policy", policy)
 	d.Set("name", name)
 
+	// The hash is taken from the policy stored in Vault, so changes made
+	// outside of Terraform also show up as a diff against source_file.
+	if d.Get("source_file").(string) != "" {
+		d.Set("source_file_hash", policyHash(policy))
+	} else {
+		d.Set("source_file_hash", "")
+	}
+
 	return nil
 }
@@ -2,6 +2,9 @@ package vault
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -117,3 +120,89 @@ func testResourcePolicy_updateCheck(s *terraform.State) error {
 
 	return nil
 }
+
+func TestResourcePolicy_sourceFile(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-")
+	sourceFile := filepath.Join(t.TempDir(), "policy.hcl")
+	readPolicy := "path \"secret/*\" {\n\tcapabilities = [\"read\"]\n}\n"
+	writePolicy := "path \"secret/*\" {\n\tcapabilities = [\"update\"]\n}\n"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() { testResourcePolicy_writeSourceFile(t, sourceFile, readPolicy) },
+				Config:    testResourcePolicy_sourceFileConfig(name, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "policy", readPolicy),
+					resource.TestCheckResourceAttr("vault_policy.test", "source_file_hash", policyHash(readPolicy)),
+					testResourcePolicy_checkVault(name, readPolicy),
+				),
+			},
+			{
+				PreConfig: func() { testResourcePolicy_writeSourceFile(t, sourceFile, writePolicy) },
+				Config:    testResourcePolicy_sourceFileConfig(name, sourceFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "policy", writePolicy),
+					resource.TestCheckResourceAttr("vault_policy.test", "source_file_hash", policyHash(writePolicy)),
+					testResourcePolicy_checkVault(name, writePolicy),
+				),
+			},
+			{
+				PreConfig:   func() { testResourcePolicy_writeSourceFile(t, sourceFile, "path \"secret/*\" {") },
+				Config:      testResourcePolicy_sourceFileConfig(name, sourceFile),
+				ExpectError: regexp.MustCompile(`is not valid HCL`),
+			},
+		},
+	})
+}
+
+func TestPolicyReadSourceFile(t *testing.T) {
+	dir := t.TempDir()
+
+	valid := filepath.Join(dir, "valid.hcl")
+	testResourcePolicy_writeSourceFile(t, valid, "path \"secret/*\" {\n\tcapabilities = [\"read\"]\n}\n")
+	if _, err := policyReadSourceFile(valid); err != nil {
+		t.Fatalf("unexpected error for valid policy: %s", err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.hcl")
+	testResourcePolicy_writeSourceFile(t, invalid, "path \"secret/*\" {")
+	if _, err := policyReadSourceFile(invalid); err == nil {
+		t.Fatal("expected an error for invalid policy")
+	}
+
+	if _, err := policyReadSourceFile(filepath.Join(dir, "missing.hcl")); err == nil {
+		t.Fatal("expected an error for a missing file")
+	}
+}
+
+func testResourcePolicy_writeSourceFile(t *testing.T, path, policy string) {
+	if err := ioutil.WriteFile(path, []byte(policy), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testResourcePolicy_checkVault(name, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		policy, err := client.Sys().GetPolicy(name)
+		if err != nil {
+			return fmt.Errorf("error reading back policy: %s", err)
+		}
+		if policy != expected {
+			return fmt.Errorf("policy data is %q; want %q", policy, expected)
+		}
+		return nil
+	}
+}
+
+func testResourcePolicy_sourceFileConfig(name, sourceFile string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+	name        = "%s"
+	source_file = "%s"
+}
+`, name, sourceFile)
+}
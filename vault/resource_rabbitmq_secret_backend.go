@@ -1,11 +1,13 @@
 package vault
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/vault/api"
 	"log"
 	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
 )
 
 func rabbitmqSecretBackendResource() *schema.Resource {
@@ -77,6 +79,18 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies whether to verify connection URI, username, and password.",
 			},
+			"lease_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Default TTL in seconds of the credentials issued by the backend's roles. 0 uses the mount's default.",
+			},
+			"lease_max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Maximum TTL in seconds of the credentials issued by the backend's roles. 0 uses the mount's maximum.",
+			},
 		},
 	}
 }
@@ -121,6 +135,9 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error configuring connection credentials for %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Wrote connection credentials to %q", path+"/config/connection")
+	if err := rabbitmqSecretBackendWriteLease(d, client, path); err != nil {
+		return err
+	}
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
 }
@@ -151,6 +168,41 @@ func rabbitmqSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	// the API doesn't support it
 	// So... if they drift, they drift.
 
+	leasePath := path + "/config/lease"
+	log.Printf("[DEBUG] Reading lease config from %q", leasePath)
+	lease, err := client.Logical().Read(leasePath)
+	if err != nil {
+		return fmt.Errorf("error reading lease config from %q: %s", leasePath, err)
+	}
+	log.Printf("[DEBUG] Read lease config from %q", leasePath)
+	if lease != nil {
+		for k, field := range map[string]string{"ttl": "lease_ttl", "max_ttl": "lease_max_ttl"} {
+			if v, ok := lease.Data[k].(json.Number); ok {
+				i, err := v.Int64()
+				if err != nil {
+					return fmt.Errorf("unexpected value %q for %s in %q: %s", v, k, leasePath, err)
+				}
+				d.Set(field, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+func rabbitmqSecretBackendWriteLease(d *schema.ResourceData, client *api.Client, path string) error {
+	leasePath := path + "/config/lease"
+	data := map[string]interface{}{
+		"ttl":     d.Get("lease_ttl").(int),
+		"max_ttl": d.Get("lease_max_ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Writing lease config to %q", leasePath)
+	if _, err := client.Logical().Write(leasePath, data); err != nil {
+		return fmt.Errorf("error writing lease config to %q: %s", leasePath, err)
+	}
+	log.Printf("[DEBUG] Wrote lease config to %q", leasePath)
+
 	return nil
 }
 
@@ -185,6 +237,11 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		log.Printf("[DEBUG] Updated root credentials at %q", path+"/config/connection")
 	}
+	if d.HasChange("lease_ttl") || d.HasChange("lease_max_ttl") {
+		if err := rabbitmqSecretBackendWriteLease(d, client, path); err != nil {
+			return err
+		}
+	}
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
 }
@@ -27,6 +27,8 @@ func TestAccRabbitmqSecretBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "description", "test description"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "default_lease_ttl_seconds", "3600"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "max_lease_ttl_seconds", "86400"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "lease_ttl", "0"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "lease_max_ttl", "0"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "connection_uri", connectionUri),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username", username),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "password", password),
@@ -39,6 +41,8 @@ func TestAccRabbitmqSecretBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "description", "test description"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "default_lease_ttl_seconds", "1800"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "max_lease_ttl_seconds", "43200"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "lease_ttl", "600"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "lease_max_ttl", "1200"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "connection_uri", connectionUri),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "username", username),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend.test", "password", password),
@@ -122,6 +126,8 @@ resource "vault_rabbitmq_secret_backend" "test" {
   description = "test description"
   default_lease_ttl_seconds = 1800
   max_lease_ttl_seconds = 43200
+  lease_ttl = 600
+  lease_max_ttl = 1200
   connection_uri = "%s"
   username = "%s"
   password = "%s"
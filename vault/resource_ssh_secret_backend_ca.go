@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -13,10 +14,12 @@ func sshSecretBackendCAResource() *schema.Resource {
 	return &schema.Resource{
 		Create: sshSecretBackendCACreate,
 		Read:   sshSecretBackendCARead,
+		Update: sshSecretBackendCAUpdate,
 		Delete: sshSecretBackendCADelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: sshSecretBackendCACustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -39,7 +42,6 @@ func sshSecretBackendCAResource() *schema.Resource {
 			"private_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Sensitive:   true,
 				Computed:    true,
 				Description: "Private key part the SSH CA key pair; required if generate_signing_key is false.",
@@ -47,10 +49,15 @@ func sshSecretBackendCAResource() *schema.Resource {
 			"public_key": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"force_rotate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Change this value to have Vault generate a new signing key pair. Requires generate_signing_key to be true.",
+			},
 		},
 	}
 }
@@ -59,6 +66,43 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	backend := d.Get("backend").(string)
 
+	if err := sshSecretBackendCAWrite(d, client, backend); err != nil {
+		return err
+	}
+
+	d.SetId(backend)
+	return sshSecretBackendCARead(d, meta)
+}
+
+// sshSecretBackendCAUpdate rotates the CA key pair when force_rotate or the
+// provided key pair changed. Vault doesn't replace an existing CA, so it is
+// deleted before the new key pair is written. Keys signed by the previous CA
+// are no longer trusted by hosts that are configured with the new public key.
+func sshSecretBackendCAUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	// Any other update, e.g. adding force_rotate to the state of an earlier
+	// version of the provider, must leave the CA alone.
+	if !d.HasChanges("force_rotate", "private_key", "public_key") {
+		return sshSecretBackendCARead(d, meta)
+	}
+
+	log.Printf("[WARN] Rotating the CA of SSH backend %q, keys signed by the previous CA need to be signed again", backend)
+	if err := sshSecretBackendCADelete(d, meta); err != nil {
+		return err
+	}
+
+	if err := sshSecretBackendCAWrite(d, client, backend); err != nil {
+		// The previous CA is gone, so its public key must not be kept.
+		d.Set("public_key", "")
+		return fmt.Errorf("the previous CA of SSH backend %q was removed, but the new one could not be written: %s", backend, err)
+	}
+
+	return sshSecretBackendCARead(d, meta)
+}
+
+func sshSecretBackendCAWrite(d *schema.ResourceData, client *api.Client, backend string) error {
 	data := make(map[string]interface{})
 	if generateSigningKey, ok := d.Get("generate_signing_key").(bool); ok {
 		data["generate_signing_key"] = generateSigningKey
@@ -77,8 +121,26 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Written CA information on SSH backend %q", backend)
 
-	d.SetId(backend)
-	return sshSecretBackendCARead(d, meta)
+	return nil
+}
+
+// sshSecretBackendCACustomizeDiff shows the public key as changing when
+// force_rotate has Vault generate a new key pair, whose public key is only
+// known after apply. A provided key pair is rotated by changing private_key
+// and public_key, whose new values are already known.
+func sshSecretBackendCACustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Switching between a generated and a provided key pair replaces the CA
+	// anyway.
+	if d.Id() == "" || d.HasChange("generate_signing_key") || !d.HasChange("force_rotate") {
+		return nil
+	}
+
+	if !d.Get("generate_signing_key").(bool) {
+		return fmt.Errorf("force_rotate requires generate_signing_key to be true, " +
+			"change private_key and public_key instead to rotate a provided key pair")
+	}
+
+	return d.SetNewComputed("public_key")
 }
 
 func sshSecretBackendCARead(d *schema.ResourceData, meta interface{}) error {
@@ -109,6 +171,13 @@ func sshSecretBackendCARead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("public_key", secret.Data["public_key"])
 	d.Set("backend", backend)
 
+	// force_rotate only exists in Terraform and is missing from imported
+	// state and from state written by earlier versions of the provider,
+	// where its default would otherwise show as a change.
+	if _, ok := d.GetOkExists("force_rotate"); !ok {
+		d.Set("force_rotate", 0)
+	}
+
 	// the API doesn't return private_key and generate_signing_key
 	// So... if they drift, they drift.
 
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendConfigZeroAddressResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sshSecretBackendConfigZeroAddressWrite,
+		Update: sshSecretBackendConfigZeroAddressWrite,
+		Read:   sshSecretBackendConfigZeroAddressRead,
+		Delete: sshSecretBackendConfigZeroAddressDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ssh",
+				ForceNew:    true,
+				Description: "The path of the SSH Secret Backend where the zero-address roles should be configured",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"roles": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the roles that are allowed to issue credentials for any IP address.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendConfigZeroAddressWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+
+	roles := expandStringSlice(d.Get("roles").(*schema.Set).List())
+	data := map[string]interface{}{
+		"roles": strings.Join(roles, ","),
+	}
+
+	log.Printf("[DEBUG] Writing zero-address roles on SSH backend %q", backend)
+	_, err := client.Logical().Write(backend+"/config/zeroaddress", data)
+	if err != nil {
+		// Vault rejects the whole list if any of the roles does not exist,
+		// the response names the offending role.
+		return fmt.Errorf("Error writing zero-address roles %v for SSH backend %q: %s", roles, backend, err)
+	}
+	log.Printf("[DEBUG] Written zero-address roles on SSH backend %q", backend)
+
+	d.SetId(backend)
+	return sshSecretBackendConfigZeroAddressRead(d, meta)
+}
+
+func sshSecretBackendConfigZeroAddressRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Reading zero-address roles from SSH backend %q", backend)
+	secret, err := client.Logical().Read(backend + "/config/zeroaddress")
+	if err != nil {
+		return fmt.Errorf("Error reading zero-address roles from SSH backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Read zero-address roles from SSH backend %q", backend)
+	if secret == nil {
+		log.Printf("[WARN] Zero-address roles not found in SSH backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	if err := d.Set("roles", secret.Data["roles"]); err != nil {
+		return fmt.Errorf("Error setting roles in state for SSH backend %q: %s", backend, err)
+	}
+
+	return nil
+}
+
+func sshSecretBackendConfigZeroAddressDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	log.Printf("[DEBUG] Deleting zero-address roles for SSH backend %q", backend)
+	_, err := client.Logical().Delete(backend + "/config/zeroaddress")
+	if err != nil {
+		return fmt.Errorf("Error deleting zero-address roles for SSH backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Deleted zero-address roles for SSH backend %q", backend)
+
+	return nil
+}
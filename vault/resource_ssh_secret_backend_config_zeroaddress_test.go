@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSSHSecretBackendConfigZeroAddress(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	resourceName := "vault_ssh_secret_backend_config_zeroaddress.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendConfigZeroAddressDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendConfigZeroAddressConfig(backend, `[vault_ssh_secret_backend_role.otp1.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp1"),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendConfigZeroAddressConfig(backend,
+					`[vault_ssh_secret_backend_role.otp1.name, vault_ssh_secret_backend_role.otp2.name]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "roles.*", "otp2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccSSHSecretBackendConfigZeroAddressConfig(backend, `["missing"]`),
+				ExpectError: regexp.MustCompile(`missing`),
+			},
+		},
+	})
+}
+
+func testAccCheckSSHSecretBackendConfigZeroAddressDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ssh_secret_backend_config_zeroaddress" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID + "/config/zeroaddress")
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("zero-address roles still exist for backend %q", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSSHSecretBackendConfigZeroAddressConfig(backend, roles string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "otp1" {
+  name         = "otp1"
+  backend      = vault_mount.example.path
+  default_user = "usr"
+  key_type     = "otp"
+  cidr_list    = "0.0.0.0/0"
+}
+
+resource "vault_ssh_secret_backend_role" "otp2" {
+  name         = "otp2"
+  backend      = vault_mount.example.path
+  default_user = "usr"
+  key_type     = "otp"
+  cidr_list    = "0.0.0.0/0"
+}
+
+resource "vault_ssh_secret_backend_config_zeroaddress" "test" {
+  backend = vault_mount.example.path
+  roles   = %s
+}
+`, backend, roles)
+}
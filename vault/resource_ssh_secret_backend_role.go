@@ -10,6 +10,10 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// sshSecretBackendRoleTemplateFields enable identity templating of the role's
+// users. Older versions of Vault ignore them.
+var sshSecretBackendRoleTemplateFields = []string{"allowed_users_template", "default_user_template"}
+
 var (
 	sshSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	sshSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
@@ -100,6 +104,11 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"default_user_template": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"key_id_format": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -172,9 +181,12 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["default_critical_options"] = v
 	}
 
-	if v, ok := d.GetOk("allowed_users_template"); ok {
-		data["allowed_users_template"] = v.(bool)
-
+	// Only send the template flags when enabled or being disabled, so that
+	// roles not using them can still be written to older versions of Vault.
+	for _, k := range sshSecretBackendRoleTemplateFields {
+		if v := d.Get(k).(bool); v || d.HasChange(k) {
+			data[k] = v
+		}
 	}
 
 	if v, ok := d.GetOk("allowed_users"); ok {
@@ -206,16 +218,49 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Writing role %q on SSH backend %q", name, backend)
-	_, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error writing role %q for backend %q: %s", name, backend, err)
 	}
 	log.Printf("[DEBUG] Wrote role %q on SSH backend %q", name, backend)
 
 	d.SetId(path)
+
+	if unsupported := sshSecretBackendRoleIgnoredTemplates(d, resp); len(unsupported) > 0 {
+		version := "unknown"
+		if status, err := client.Sys().SealStatus(); err == nil {
+			version = status.Version
+		}
+		return fmt.Errorf("role %q for backend %q was written without %s, which are not supported by Vault %s",
+			name, backend, strings.Join(unsupported, ", "), version)
+	}
+
 	return sshSecretBackendRoleRead(d, meta)
 }
 
+// sshSecretBackendRoleIgnoredTemplates returns the enabled template fields
+// that Vault reported as unrecognized when writing the role, which it does
+// for parameters it doesn't support.
+func sshSecretBackendRoleIgnoredTemplates(d *schema.ResourceData, resp *api.Secret) []string {
+	if resp == nil {
+		return nil
+	}
+
+	var ignored []string
+	for _, k := range sshSecretBackendRoleTemplateFields {
+		if !d.Get(k).(bool) {
+			continue
+		}
+		for _, w := range resp.Warnings {
+			if strings.Contains(w, "unrecognized parameters") && strings.Contains(w, k) {
+				ignored = append(ignored, k)
+				break
+			}
+		}
+	}
+	return ignored
+}
+
 func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -263,6 +308,10 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("allowed_users_template", role.Data["allowed_users_template"])
 	d.Set("allowed_users", role.Data["allowed_users"])
 	d.Set("default_user", role.Data["default_user"])
+	// Older versions of Vault don't support templating the default user.
+	if v, ok := role.Data["default_user_template"]; ok {
+		d.Set("default_user_template", v)
+	}
 	d.Set("key_id_format", role.Data["key_id_format"])
 	d.Set("allowed_user_key_lengths", role.Data["allowed_user_key_lengths"])
 	d.Set("max_ttl", role.Data["max_ttl"])
@@ -139,6 +139,63 @@ func TestAccSSHSecretBackendRole_import(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendRole_template(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test/ssh")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resourceName := "vault_ssh_secret_backend_role.test_role"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccSSHSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_template(name, backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_users_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_users", "{{identity.entity.metadata.ssh_username}},admin"),
+					resource.TestCheckResourceAttr(resourceName, "default_user_template", "true"),
+					resource.TestCheckResourceAttr(resourceName, "default_user", "{{identity.entity.metadata.ssh_username}}"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSSHSecretBackendRoleConfig_template(name, backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_users_template", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_user_template", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestSSHSecretBackendRoleIgnoredTemplates(t *testing.T) {
+	d := sshSecretBackendRoleResource().TestResourceData()
+	d.Set("allowed_users_template", true)
+	d.Set("default_user_template", true)
+
+	resp := &api.Secret{
+		Warnings: []string{"Endpoint ignored these unrecognized parameters: [default_user_template]"},
+	}
+	got := sshSecretBackendRoleIgnoredTemplates(d, resp)
+	if len(got) != 1 || got[0] != "default_user_template" {
+		t.Errorf("expected only default_user_template to be ignored, got %v", got)
+	}
+
+	d.Set("default_user_template", false)
+	if got := sshSecretBackendRoleIgnoredTemplates(d, resp); len(got) != 0 {
+		t.Errorf("expected disabled fields not to be reported, got %v", got)
+	}
+
+	if got := sshSecretBackendRoleIgnoredTemplates(d, nil); len(got) != 0 {
+		t.Errorf("expected no ignored fields without a response, got %v", got)
+	}
+}
+
 func testAccSSHSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -224,3 +281,23 @@ resource "vault_ssh_secret_backend_role" "test_role" {
 }
 `, path, name)
 }
+
+func testAccSSHSecretBackendRoleConfig_template(name, path string, template bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test_role" {
+  name                    = "%s"
+  backend                 = vault_mount.example.path
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users_template  = %t
+  allowed_users           = "{{identity.entity.metadata.ssh_username}},admin"
+  default_user_template   = %t
+  default_user            = "{{identity.entity.metadata.ssh_username}}"
+}
+`, path, name, template, template)
+}
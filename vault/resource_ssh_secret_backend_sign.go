@@ -0,0 +1,158 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendSignResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sshSecretBackendSignCreate,
+		Read:   sshSecretBackendSignRead,
+		Delete: sshSecretBackendSignDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ssh",
+				ForceNew:    true,
+				Description: "The path of the SSH Secret Backend to sign the key with.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role to sign the key against.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SSH public key that should be signed.",
+			},
+			"valid_principals": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "List of usernames or hostnames the certificate is valid for.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "user",
+				ForceNew:     true,
+				Description:  "Type of certificate to create, either 'user' or 'host'.",
+				ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Key ID that the created certificate should have.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Requested time to live of the certificate.",
+				ValidateFunc: validateDuration,
+			},
+			"critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Critical options that the certificate should be signed for.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Extensions that the certificate should be signed for.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"signed_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed SSH certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendSignCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	role := d.Get("role").(string)
+	path := sshSecretBackendSignPath(backend, role)
+
+	data := map[string]interface{}{
+		"public_key": d.Get("public_key").(string),
+		"cert_type":  d.Get("cert_type").(string),
+	}
+
+	if v := expandStringSlice(d.Get("valid_principals").([]interface{})); len(v) > 0 {
+		data["valid_principals"] = strings.Join(v, ",")
+	}
+	if v, ok := d.GetOk("key_id"); ok {
+		data["key_id"] = v.(string)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+	if v, ok := d.GetOk("critical_options"); ok {
+		data["critical_options"] = v.(map[string]interface{})
+	}
+	if v, ok := d.GetOk("extensions"); ok {
+		data["extensions"] = v.(map[string]interface{})
+	}
+
+	log.Printf("[DEBUG] Signing SSH key with %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing SSH key with %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Signed SSH key with %q", path)
+
+	if resp == nil {
+		return fmt.Errorf("no signed key returned from %q", path)
+	}
+
+	serial, _ := resp.Data["serial_number"].(string)
+	d.Set("signed_key", resp.Data["signed_key"])
+	d.Set("serial_number", serial)
+
+	d.SetId(fmt.Sprintf("%s/%s", path, serial))
+	return sshSecretBackendSignRead(d, meta)
+}
+
+func sshSecretBackendSignRead(d *schema.ResourceData, meta interface{}) error {
+	// the signed certificate cannot be read back from Vault
+	return nil
+}
+
+func sshSecretBackendSignDelete(d *schema.ResourceData, meta interface{}) error {
+	// SSH certificates cannot be revoked, they expire on their own
+	return nil
+}
+
+func sshSecretBackendSignPath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/sign/" + strings.Trim(role, "/")
+}
@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testAccSSHSecretBackendSignPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOgy/NmJM/km46ViKCfumkDpNN7FGzwfjT0+tjPIgmlV"
+
+func TestAccSSHSecretBackendSign_basic(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	resourceName := "vault_ssh_secret_backend_sign.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendSignConfig(backend, "1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role", "test"),
+					resource.TestCheckResourceAttr(resourceName, "cert_type", "user"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					testAccSSHSecretBackendSignCheckSignedKey(resourceName),
+				),
+			},
+			{
+				// changing any input signs the key again
+				Config: testAccSSHSecretBackendSignConfig(backend, "2h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "2h"),
+					testAccSSHSecretBackendSignCheckSignedKey(resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendSignCheckSignedKey(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		signedKey := rs.Primary.Attributes["signed_key"]
+		if !strings.HasPrefix(signedKey, "ssh-ed25519-cert-v01@openssh.com ") {
+			return fmt.Errorf("expected an ed25519 certificate, got %q", signedKey)
+		}
+		return nil
+	}
+}
+
+func testAccSSHSecretBackendSignConfig(backend, ttl string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.example.path
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  name                    = "test"
+  backend                 = vault_mount.example.path
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "usr1,usr2"
+  default_extensions = {
+    "permit-pty" = ""
+  }
+  allowed_extensions = "permit-pty,permit-port-forwarding"
+  max_ttl            = "86400"
+
+  depends_on = [vault_ssh_secret_backend_ca.test]
+}
+
+resource "vault_ssh_secret_backend_sign" "test" {
+  backend          = vault_mount.example.path
+  role             = vault_ssh_secret_backend_role.test.name
+  public_key       = "%s"
+  valid_principals = ["usr1"]
+  ttl              = "%s"
+  extensions = {
+    "permit-pty" = ""
+  }
+}
+`, backend, testAccSSHSecretBackendSignPublicKey, ttl)
+}
@@ -55,18 +55,36 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
 			Description: "List of disallowed policies for given role.",
 		},
+		"allowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
+			Description: "Set of allowed policies with glob match for given role.",
+		},
+		"disallowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
+			Description: "Set of disallowed policies with glob match for given role.",
+		},
 		"orphan": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     false,
-			Description: "If true, tokens created against this policy will be orphan tokens.",
+			Description: "If true, tokens created against this role will be orphan tokens.",
 		},
 
 		"renewable": {
 			Type:        schema.TypeBool,
 			Optional:    true,
 			Default:     true,
-			Description: "Whether to disable the ability of the token to be renewed past its initial TTL.",
+			Description: "Whether tokens created against this role can be renewed past their initial TTL. Set to false to disable renewal.",
 		},
 		"path_suffix": {
 			Type:        schema.TypeString,
@@ -122,6 +140,8 @@ func tokenAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]in
 
 	data["allowed_policies"] = d.Get("allowed_policies").(*schema.Set).List()
 	data["disallowed_policies"] = d.Get("disallowed_policies").(*schema.Set).List()
+	data["allowed_policies_glob"] = d.Get("allowed_policies_glob").(*schema.Set).List()
+	data["disallowed_policies_glob"] = d.Get("disallowed_policies_glob").(*schema.Set).List()
 	data["orphan"] = d.Get("orphan").(bool)
 	data["renewable"] = d.Get("renewable").(bool)
 	data["path_suffix"] = d.Get("path_suffix").(string)
@@ -184,7 +204,9 @@ func tokenAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	readTokenFields(d, resp)
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
 
 	d.Set("role_name", roleName)
 
@@ -227,7 +249,7 @@ func tokenAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	for _, k := range []string{"allowed_policies", "disallowed_policies", "orphan", "path_suffix", "renewable"} {
+	for _, k := range []string{"allowed_policies", "disallowed_policies", "allowed_policies_glob", "disallowed_policies_glob", "orphan", "path_suffix", "renewable"} {
 		if err := d.Set(k, resp.Data[k]); err != nil {
 			return fmt.Errorf("error reading %s for Token auth backend role %q: %q", k, path, err)
 		}
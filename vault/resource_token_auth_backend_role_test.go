@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"testing"
@@ -123,6 +124,181 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 	})
 }
 
+func TestAccTokenAuthBackendRoleNonRenewable(t *testing.T) {
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckTokenAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenAuthBackendRoleConfigIssuedToken(role, false, true, "parth-suffix"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTokenAuthBackendRoleCheck_attrs(role),
+					testAccTokenAuthBackendRoleCheck_issuedToken(role, false, "parth-suffix"),
+					testAccTokenAuthBackendRoleCheck_orphan(role, true),
+				),
+			},
+			{
+				Config: testAccTokenAuthBackendRoleConfigIssuedToken(role, true, false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTokenAuthBackendRoleCheck_attrs(role),
+					testAccTokenAuthBackendRoleCheck_issuedToken(role, true, ""),
+					testAccTokenAuthBackendRoleCheck_orphan(role, false),
+				),
+			},
+		},
+	})
+}
+
+// testAccTokenAuthBackendRoleIssuedTokenPolicy is the only policy allowed by
+// testAccTokenAuthBackendRoleConfigIssuedToken, and the one requested for
+// the tokens issued against it.
+const testAccTokenAuthBackendRoleIssuedTokenPolicy = "dev"
+
+// testAccTokenAuthBackendRoleIssueToken creates a service token against the
+// role and looks it up by its accessor. The token is revoked by calling the
+// returned function.
+func testAccTokenAuthBackendRoleIssueToken(role string) (*api.Secret, *api.Secret, func(), error) {
+	client := testProvider.Meta().(*api.Client)
+
+	secret, err := client.Auth().Token().CreateWithRole(&api.TokenCreateRequest{
+		Policies: []string{testAccTokenAuthBackendRoleIssuedTokenPolicy},
+	}, role)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error creating token against role %q: %s", role, err)
+	}
+	revoke := func() {
+		client.Auth().Token().RevokeAccessor(secret.Auth.Accessor)
+	}
+
+	lookup, err := client.Auth().Token().LookupAccessor(secret.Auth.Accessor)
+	if err != nil {
+		revoke()
+		return nil, nil, nil, fmt.Errorf("error looking up token: %s", err)
+	}
+
+	return secret, lookup, revoke, nil
+}
+
+// testAccTokenAuthBackendRoleCheck_issuedToken creates a token against the
+// role and verifies that it honours the role's renewable and path_suffix
+// settings.
+func testAccTokenAuthBackendRoleCheck_issuedToken(role string, renewable bool, pathSuffix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		secret, lookup, revoke, err := testAccTokenAuthBackendRoleIssueToken(role)
+		if err != nil {
+			return err
+		}
+		defer revoke()
+
+		if secret.Auth.Renewable != renewable {
+			return fmt.Errorf("expected token renewable to be %t, got %t", renewable, secret.Auth.Renewable)
+		}
+
+		expectedPath := "auth/token/create/" + role
+		if pathSuffix != "" {
+			expectedPath += "/" + pathSuffix
+		}
+		if lookup.Data["path"] != expectedPath {
+			return fmt.Errorf("expected token path to be %q, got %q", expectedPath, lookup.Data["path"])
+		}
+
+		return nil
+	}
+}
+
+// testAccTokenAuthBackendRoleCheck_orphan creates a token against the role
+// and verifies whether it is an orphan.
+func testAccTokenAuthBackendRoleCheck_orphan(role string, orphan bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, lookup, revoke, err := testAccTokenAuthBackendRoleIssueToken(role)
+		if err != nil {
+			return err
+		}
+		defer revoke()
+
+		if lookup.Data["orphan"] != orphan {
+			return fmt.Errorf("expected token orphan to be %t, got %v", orphan, lookup.Data["orphan"])
+		}
+
+		return nil
+	}
+}
+
+func TestAccTokenAuthBackendRoleGlobPolicies(t *testing.T) {
+	role := acctest.RandomWithPrefix("test-role")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			// The policy globs of token roles were added in Vault 1.8.
+			testAccSkipIfVaultVersionBefore(t, 1, 8)
+		},
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckTokenAuthBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenAuthBackendRoleConfigGlobPolicies(role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTokenAuthBackendRoleCheck_attrs(role),
+					testAccTokenAuthBackendRoleCheck_globPolicies(role, []string{"dev-*", "test-*"}, []string{"dev-admin*"}),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.*", "dev-*"),
+					resource.TestCheckTypeSetElemAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.*", "test-*"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies_glob.#", "1"),
+					resource.TestCheckTypeSetElemAttr("vault_token_auth_backend_role.role", "disallowed_policies_glob.*", "dev-admin*"),
+				),
+			},
+			{
+				Config: testAccTokenAuthBackendRoleConfig(role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccTokenAuthBackendRoleCheck_attrs(role),
+					testAccTokenAuthBackendRoleCheck_globPolicies(role, nil, nil),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "0"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies_glob.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccTokenAuthBackendRoleCheck_globPolicies verifies the policy globs of
+// the role in Vault.
+func testAccTokenAuthBackendRoleCheck_globPolicies(role string, allowed, disallowed []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().Read("auth/token/roles/" + role)
+		if err != nil {
+			return fmt.Errorf("error reading token role %q: %s", role, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("token role %q not found", role)
+		}
+
+		for k, expected := range map[string][]string{
+			"allowed_policies_glob":    allowed,
+			"disallowed_policies_glob": disallowed,
+		} {
+			var actual []string
+			if v, ok := resp.Data[k].([]interface{}); ok {
+				for _, p := range v {
+					actual = append(actual, p.(string))
+				}
+			}
+			sort.Strings(actual)
+			if strings.Join(actual, ",") != strings.Join(expected, ",") {
+				return fmt.Errorf("expected %s of token role %q to be %v, got %v", k, role, expected, actual)
+			}
+		}
+
+		return nil
+	}
+}
+
 func TestAccTokenAuthBackendRoleDeprecated(t *testing.T) {
 	role := acctest.RandomWithPrefix("test-role")
 	roleUpdated := acctest.RandomWithPrefix("test-role-updated")
@@ -343,6 +519,21 @@ resource "vault_token_auth_backend_role" "role" {
 }`, role)
 }
 
+// testAccTokenAuthBackendRoleConfigIssuedToken configures a role issuing
+// service tokens, which unlike batch tokens have an accessor and can be
+// renewable.
+func testAccTokenAuthBackendRoleConfigIssuedToken(role string, renewable, orphan bool, pathSuffix string) string {
+	return fmt.Sprintf(`
+resource "vault_token_auth_backend_role" "role" {
+  role_name        = "%s"
+  allowed_policies = [%q]
+  renewable        = %t
+  orphan           = %t
+  path_suffix      = %q
+  token_type       = "service"
+}`, role, testAccTokenAuthBackendRoleIssuedTokenPolicy, renewable, orphan, pathSuffix)
+}
+
 func testAccTokenAuthBackendRoleConfigDeprecated(role string) string {
 	return fmt.Sprintf(`
 resource "vault_token_auth_backend_role" "role" {
@@ -358,3 +549,13 @@ resource "vault_token_auth_backend_role" "role" {
   token_type = "default-batch"
 }`, role)
 }
+
+func testAccTokenAuthBackendRoleConfigGlobPolicies(role string) string {
+	return fmt.Sprintf(`
+resource "vault_token_auth_backend_role" "role" {
+  role_name                = "%s"
+  allowed_policies         = ["ops"]
+  allowed_policies_glob    = ["dev-*", "test-*"]
+  disallowed_policies_glob = ["dev-admin*"]
+}`, role)
+}
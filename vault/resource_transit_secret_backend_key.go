@@ -120,6 +120,12 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Description: "Minimum key version to use for encryption",
 				Default:     0,
 			},
+			"auto_rotate_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Amount of time in seconds the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key.",
+				ValidateFunc: validateTransitKeyAutoRotatePeriod,
+			},
 			"supports_encryption": {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -186,12 +192,17 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
 	}
 
+	// Only send auto_rotate_period when set, Vault < 1.10 doesn't support it.
+	if v, ok := d.GetOk("auto_rotate_period"); ok {
+		configData["auto_rotate_period"] = v.(int)
+	}
+
 	data := map[string]interface{}{
 		"convergent_encryption": d.Get("convergent_encryption").(bool),
 		"derived":               d.Get("derived").(bool),
@@ -306,6 +317,15 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("supports_signing", secret.Data["supports_signing"].(bool))
 	d.Set("type", secret.Data["type"].(string))
 
+	// Older versions of Vault do not support automatic rotation. When
+	// enabled, Vault rotates the key on its own, which is reported through
+	// latest_version and keys on the next refresh.
+	if v, ok := secret.Data["auto_rotate_period"]; ok {
+		if err := d.Set("auto_rotate_period", v); err != nil {
+			return fmt.Errorf("error setting state key \"auto_rotate_period\": %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -323,6 +343,10 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup"),
 	}
 
+	if d.HasChange("auto_rotate_period") {
+		data["auto_rotate_period"] = d.Get("auto_rotate_period")
+	}
+
 	_, err := client.Logical().Write(path+"/config", data)
 	if err != nil {
 		return fmt.Errorf("error updating transit secret backend key %q: %s", path, err)
@@ -383,3 +407,14 @@ func transitSecretBackendKeyBackendFromPath(path string) (string, error) {
 	}
 	return res[1], nil
 }
+
+// validateTransitKeyAutoRotatePeriod ensures auto_rotate_period is either 0,
+// disabling automatic rotation, or at least an hour, the minimum Vault
+// accepts.
+func validateTransitKeyAutoRotatePeriod(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(int)
+	if v != 0 && v < 3600 {
+		errs = append(errs, fmt.Errorf("%q must be 0 or at least 3600 seconds, got: %d", key, v))
+	}
+	return
+}
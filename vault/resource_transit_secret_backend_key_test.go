@@ -144,6 +144,59 @@ func TestTransitSecretBackendKey_import(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_autoRotate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+				),
+			},
+			{
+				// Rotations outside of Terraform are picked up on refresh,
+				// without replacing the key.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					path := transitSecretBackendKeyPath(backend, name) + "/rotate"
+					if _, err := client.Logical().Write(path, nil); err != nil {
+						t.Fatalf("error rotating key %q: %s", path, err)
+					}
+				},
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "2"),
+				),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_autoRotate(name, backend, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "0"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+			{
+				Config:      testTransitSecretBackendKeyConfig_autoRotate(name, backend, 60),
+				ExpectError: regexp.MustCompile(`"auto_rotate_period" must be 0 or at least 3600 seconds`),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testTransitSecretBackendKeyConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -233,6 +286,22 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_autoRotate(name, path string, period int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend            = vault_mount.transit.path
+  name               = "%s"
+  deletion_allowed   = true
+  auto_rotate_period = %d
+}
+`, path, name, period)
+}
+
 func testTransitSecretBackendKeyCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
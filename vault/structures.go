@@ -2,6 +2,7 @@ package vault
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -18,10 +19,10 @@ func expandAuthMethodTune(rawL []interface{}) api.MountConfigInput {
 	raw := rawL[0].(map[string]interface{})
 
 	if v, ok := raw["default_lease_ttl"]; ok {
-		data.DefaultLeaseTTL = v.(string)
+		data.DefaultLeaseTTL = expandVaultDuration(v.(string))
 	}
 	if v, ok := raw["max_lease_ttl"]; ok {
-		data.MaxLeaseTTL = v.(string)
+		data.MaxLeaseTTL = expandVaultDuration(v.(string))
 	}
 	if v, ok := raw["audit_non_hmac_request_keys"]; ok {
 		data.AuditNonHMACRequestKeys = expandStringSliceWithEmpty(v.([]interface{}), true)
@@ -109,6 +110,20 @@ func flattenCommaSeparatedStringSlice(s string) []interface{} {
 	return vs
 }
 
+// expandVaultDuration converts a Go duration string to the number of seconds
+// Vault expects, e.g. "1h30m" becomes "5400s". Values which are not valid
+// durations are passed through for Vault to reject.
+func expandVaultDuration(v string) string {
+	if v == "" {
+		return v
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return v
+	}
+	return fmt.Sprintf("%ds", int64(d.Seconds()))
+}
+
 func flattenVaultDuration(d interface{}) string {
 	if d == nil {
 		return time.Duration(0).String()
@@ -21,8 +21,8 @@ func TestExpandAuthMethodTune(t *testing.T) {
 	}
 	actual := expandAuthMethodTune(flattened)
 	expected := api.MountConfigInput{
-		DefaultLeaseTTL:           "10m",
-		MaxLeaseTTL:               "20m",
+		DefaultLeaseTTL:           "600s",
+		MaxLeaseTTL:               "1200s",
 		AuditNonHMACRequestKeys:   []string{"foo", "bar"},
 		AuditNonHMACResponseKeys:  nil,
 		ListingVisibility:         "unauth",
@@ -39,6 +39,23 @@ func TestExpandAuthMethodTune(t *testing.T) {
 	}
 }
 
+func TestExpandVaultDuration(t *testing.T) {
+	tests := map[string]string{
+		"":          "",
+		"0s":        "0s",
+		"90s":       "90s",
+		"1h30m":     "5400s",
+		"25h":       "90000s",
+		"not-a-ttl": "not-a-ttl",
+	}
+
+	for v, want := range tests {
+		if got := expandVaultDuration(v); got != want {
+			t.Errorf("expandVaultDuration(%q) = %q, want %q", v, got, want)
+		}
+	}
+}
+
 func TestFlattenAuthMethodTune(t *testing.T) {
 	expanded := &api.MountConfigOutput{
 		DefaultLeaseTTL:           600,
@@ -3,12 +3,22 @@ package vault
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
 )
 
+// headerNameRegex matches an HTTP header field name, which must be a
+// non-empty RFC 7230 token.
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
+// genericNameRegex matches the names Vault accepts for most named objects,
+// such as roles, which are a single path segment made of word characters,
+// dashes and dots, starting and ending with a word character.
+var genericNameRegex = regexp.MustCompile(`^\w(([\w-.]+)?\w)?$`)
+
 func validateStringSlug(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -47,3 +57,30 @@ func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	}
 	return
 }
+
+func validateHeaderName(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !headerNameRegex.MatchString(v) {
+		es = append(es, fmt.Errorf("expected %s to be a valid HTTP header name, got %q", k, v))
+	}
+	return
+}
+
+func validateGenericName(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !genericNameRegex.MatchString(v) {
+		es = append(es, fmt.Errorf("expected %s to only contain letters, digits, underscores, dashes and dots, "+
+			"starting and ending with a letter, digit or underscore, got %q", k, v))
+	}
+	return
+}
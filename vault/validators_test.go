@@ -53,3 +53,50 @@ func TestValidateNoTrailingSlash(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateHeaderName(t *testing.T) {
+	testCases := []struct {
+		val     string
+		wantErr bool
+	}{
+		{val: "X-Request-Correlation-Id"},
+		{val: "x_custom.header~1"},
+		{val: "", wantErr: true},
+		{val: "X Header", wantErr: true},
+		{val: "X-Header:", wantErr: true},
+		{val: "X-Header\n", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		_, errs := validateHeaderName(tc.val, "name")
+		if tc.wantErr != (len(errs) != 0) {
+			t.Errorf("validateHeaderName(%q) errors = %v, wantErr %t", tc.val, errs, tc.wantErr)
+		}
+	}
+}
+
+func TestValidateGenericName(t *testing.T) {
+	testCases := []struct {
+		val     string
+		wantErr bool
+	}{
+		{val: "foo"},
+		{val: "f"},
+		{val: "my-role_1.2"},
+		{val: "", wantErr: true},
+		{val: "foo/bar", wantErr: true},
+		{val: "foo/", wantErr: true},
+		{val: "/foo", wantErr: true},
+		{val: "foo bar", wantErr: true},
+		{val: "foo?bar", wantErr: true},
+		{val: "-foo", wantErr: true},
+		{val: "foo.", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		_, errs := validateGenericName(tc.val, "role_name")
+		if tc.wantErr != (len(errs) != 0) {
+			t.Errorf("validateGenericName(%q) errors = %v, wantErr %t", tc.val, errs, tc.wantErr)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Vault never returns some secrets, such as passwords, once they are written.
+// These write-only fields are kept in state as configured, and are only sent
+// to Vault on create, when they change, or when their companion version field
+// changes. Changing the version writes the secret again without changing the
+// secret itself, e.g. to restore it after it was changed outside of Terraform.
+
+// writeOnlyVersionSchema returns the schema of the version field that causes
+// the write-only field to be written again.
+func writeOnlyVersionSchema(field string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: fmt.Sprintf("Arbitrary value, such as a version number or a hash of %s, "+
+			"that causes %s to be written again whenever it changes.", field, field),
+	}
+}
+
+// writeOnlyChanged reports whether the write-only field must be sent to Vault.
+func writeOnlyChanged(d *schema.ResourceData, field, versionField string) bool {
+	return d.IsNewResource() || d.HasChange(field) || d.HasChange(versionField)
+}